@@ -0,0 +1,52 @@
+package goanydate
+
+import (
+	"strings"
+	"time"
+)
+
+// AccessLogEntry locates the timestamp of an Apache or nginx access log line
+// in Common or Combined Log Format.
+type AccessLogEntry struct {
+	Time time.Time
+	// Layout is the Go layout of the timestamp alone.
+	Layout string
+	// Start and End delimit the timestamp within the line, brackets excluded.
+	Start, End int
+}
+
+// ParseAccessLog finds and parses the timestamp of an access log line with the zero Parser.
+func ParseAccessLog(line string) (AccessLogEntry, error) {
+	p := Parser{}
+	return p.ParseAccessLog(line)
+}
+
+// ParseAccessLog finds and parses the bracketed timestamp of an access log line:
+//
+//	127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326
+//
+// The timestamp must follow the host, ident and authuser fields. Any layout
+// the detector recognises is accepted inside the brackets, so nginx's
+// $time_iso8601 works as well as the classic "02/Jan/2006:15:04:05 -0700".
+func (p *Parser) ParseAccessLog(line string) (AccessLogEntry, error) {
+	open := strings.Index(line, " [")
+	if open < 0 || len(strings.Fields(line[:open])) < 3 {
+		return AccessLogEntry{}, ErrInvalidDateFormat
+	}
+	start := open + 2
+	end := strings.IndexByte(line[start:], ']')
+	if end < 0 {
+		return AccessLogEntry{}, ErrInvalidDateFormat
+	}
+	end += start
+	if end+1 < len(line) && line[end+1] != ' ' {
+		return AccessLogEntry{}, ErrInvalidDateFormat
+	}
+
+	r, err := p.ParseResult(line[start:end])
+	if err != nil {
+		return AccessLogEntry{}, err
+	}
+
+	return AccessLogEntry{Time: r.Time, Layout: r.Layout, Start: start, End: end}, nil
+}
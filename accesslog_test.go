@@ -0,0 +1,64 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAccessLog(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   time.Time
+		layout string
+	}{
+		{
+			in:     `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`,
+			want:   time.Date(2000, time.October, 10, 20, 55, 36, 0, time.UTC),
+			layout: "02/Jan/2006:15:04:05 -0700",
+		},
+		{
+			in:     `203.0.113.7 - - [12/Dec/2024:16:36:17 +0000] "GET /index.html?q=[x] HTTP/1.1" 304 0 "https://example.com/" "Mozilla/5.0 (X11; Linux x86_64)"`,
+			want:   time.Date(2024, time.December, 12, 16, 36, 17, 0, time.UTC),
+			layout: "02/Jan/2006:15:04:05 -0700",
+		},
+		{
+			in:     `::1 - - [2024-12-12T16:36:17-07:00] "POST /api HTTP/2.0" 201 17`,
+			want:   time.Date(2024, time.December, 12, 23, 36, 17, 0, time.UTC),
+			layout: "2006-01-02T15:04:05-07:00",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAccessLog(tt.in)
+		if err != nil {
+			t.Errorf("ParseAccessLog(`%s`) failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want) {
+			t.Errorf("ParseAccessLog(`%s`).Time = %v, want %v", tt.in, got.Time, tt.want)
+		}
+		if got.Layout != tt.layout {
+			t.Errorf("ParseAccessLog(`%s`).Layout = %s, want %s", tt.in, got.Layout, tt.layout)
+		}
+		if got.Start == 0 || tt.in[got.Start-1] != '[' || tt.in[got.End] != ']' {
+			t.Errorf("ParseAccessLog(`%s`) span = %d:%d", tt.in, got.Start, got.End)
+		}
+	}
+}
+
+func TestParseAccessLogErr(t *testing.T) {
+	tests := []string{
+		"",
+		`[10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.0" 200 2326`,
+		`127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700 "GET / HTTP/1.0" 200 2326`,
+		`127.0.0.1 - frank [10/Oct/2000:25:55:36 -0700] "GET / HTTP/1.0" 200 2326`,
+		`127.0.0.1 - frank [not a date] "GET / HTTP/1.0" 200 2326`,
+	}
+
+	for _, in := range tests {
+		got, err := ParseAccessLog(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("ParseAccessLog(`%s`) = %+v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
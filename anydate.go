@@ -9,6 +9,10 @@ import (
 
 var ErrInvalidDateFormat = errors.New("invalid date format")
 
+// ErrNoLayout is returned by DetectFormat for valid dates that no Go layout
+// can express, such as ISO 8601 week dates. Parse still handles them.
+var ErrNoLayout = errors.New("date format has no Go layout")
+
 var longDayNames = []string{
 	"Sunday",
 	"Monday",
@@ -124,6 +128,35 @@ func isNanoSep(v string) bool {
 	return v == "." || v == ","
 }
 
+func isOrdinalSuffix(v string) bool {
+	for _, s := range []string{"st", "nd", "rd", "th"} {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ordinalSuffix returns the English ordinal suffix of n, e.g. "nd" for 22.
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
 func isNumber(v string) bool {
 	for _, r := range v {
 		if !unicode.IsDigit(r) {
@@ -139,6 +172,21 @@ type adChunk struct {
 }
 
 type adDetector struct {
+	// calendar, when set, adds its month names to the English ones.
+	calendar Calendar
+	// order is how all-numeric dates are read.
+	order DateOrder
+	// valueDependent records that the layout depends on the digits of the
+	// input and not only on its shape, so it must not be cached.
+	valueDependent bool
+}
+
+func (d *adDetector) isCalendarMonth(name string) bool {
+	if d.calendar == nil {
+		return false
+	}
+	_, ok := d.calendar.Month(name)
+	return ok
 }
 
 // Parse attempts to extract date components from the input string
@@ -164,7 +212,7 @@ func (d *adDetector) parse(input string) []adChunk {
 				chunkType = "digit"
 				cur.WriteRune(r)
 			}
-		case unicode.IsLetter(r):
+		case unicode.IsLetter(r) || unicode.IsMark(r) && chunkType == "letter":
 			if chunkType == "" || chunkType == "letter" {
 				chunkType = "letter"
 				cur.WriteRune(r)
@@ -222,11 +270,14 @@ const (
 	ctTzSign
 	ctTzHour
 	ctTzMin
+	ctYearDay
+	ctOrdinal
 )
 
 type adComponent struct {
-	Value string
-	Type  componentType
+	Value  string
+	Type   componentType
+	Padded bool // preceded by a padding space, e.g. "Nov  6"
 }
 
 func (c *adComponent) GoFmt() string {
@@ -249,6 +300,9 @@ func (c *adComponent) GoFmt() string {
 		}
 		return "01"
 	case ctDay:
+		if c.Padded {
+			return "_2"
+		}
 		if len(c.Value) == 1 {
 			return "2"
 		}
@@ -294,6 +348,13 @@ func (c *adComponent) GoFmt() string {
 		return "07"
 	case ctTzMin:
 		return "00"
+	case ctOrdinal:
+		return c.Value
+	case ctYearDay:
+		if c.Padded {
+			return "__2"
+		}
+		return "002"
 	}
 	return ""
 }
@@ -307,7 +368,43 @@ func (d *adDetector) goFmt(components []adComponent) string {
 	return s.String()
 }
 
+// padding merges the extra space in front of a single-digit day into the day
+// itself, so "Nov  6" becomes "Jan _2" rather than "Jan  2".
+func (d *adDetector) padding(result []adComponent) {
+	for i := 2; i < len(result); i++ {
+		if result[i].Type != ctDay || len(result[i].Value) != 1 {
+			continue
+		}
+		if result[i-1].Type == ctSep && result[i-1].Value == " " &&
+			result[i-2].Type == ctSep && strings.HasSuffix(result[i-2].Value, " ") {
+			result[i-1].Value = ""
+			result[i].Padded = true
+		}
+	}
+}
+
 func (d *adDetector) extractPattern(input string) (string, error) {
+	result, err := d.extractComponents(input)
+	if err != nil {
+		return "", err
+	}
+
+	return d.goFmt(result), nil
+}
+
+// hasComponent reports whether any of the components is of one of the types.
+func hasComponent(components []adComponent, types ...componentType) bool {
+	for _, c := range components {
+		for _, t := range types {
+			if c.Type == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *adDetector) extractComponents(input string) ([]adComponent, error) {
 	components := d.parse(input)
 	result := []adComponent{}
 	prev := adComponent{}
@@ -334,13 +431,45 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 		_, exists := componentsMap[key]
 		return exists
 	}
+	// yearDayPad returns how many spaces pad v to a three digit day of year
+	// following the year, e.g. "2024-  5" or "2024   5", or -1.
+	yearDayPad := func(v string) int {
+		if !added(ctYear) || added(ctMonthNum) || added(ctDay) {
+			return -1
+		}
+		n := 0
+		i := rl - 1
+		for ; i >= 0 && result[i].Type == ctSep && result[i].Value == " "; i-- {
+			n++
+		}
+		if i >= 0 && result[i].Value == "-" {
+			i--
+		} else {
+			n-- // the first space separates rather than pads
+		}
+		if n+len(v) != 3 || i < 0 || result[i].Type != ctYear {
+			return -1
+		}
+		return n
+	}
+	addYearDay := func(v string, pad int) {
+		for i := rl - pad; i < rl; i++ {
+			result[i].Value = ""
+		}
+		add(v, ctYearDay)
+		result[len(result)-1].Padded = pad > 0
+		// the day of year stands in for both month and day
+		componentsMap[ctMonth] = len(result) - 1
+		componentsMap[ctMonthNum] = len(result) - 1
+		componentsMap[ctDay] = len(result) - 1
+	}
 
 	for _, c := range components {
 		switch c.Type {
 		case "letter":
 			if isAmPm(c.Value) {
 				add(c.Value, ctAmPm)
-			} else if !added(ctMonth) && (isShortMonth(c.Value) || isLongMonth(c.Value)) {
+			} else if !added(ctMonth) && (isShortMonth(c.Value) || isLongMonth(c.Value) || d.isCalendarMonth(c.Value)) {
 				add(c.Value, ctMonth)
 				if added(ctMonthNum) && len(result) >= 2 {
 					replaceType(ctMonthNum, ctDay)
@@ -352,13 +481,17 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 				add(c.Value, ctTzAbbr)
 			} else if c.Value == "Z" {
 				add(c.Value, ctTzSign)
+			} else if isOrdinalSuffix(c.Value) && prev.Type != ctSep && isNumber(prev.Value) {
+				add(c.Value, ctOrdinal)
 			} else {
 				add(c.Value, ctSep)
 			}
 		case "digit":
 			switch len(c.Value) {
 			case 1:
-				if !added(ctMonthNum) {
+				if pad := yearDayPad(c.Value); pad > 0 {
+					addYearDay(c.Value, pad)
+				} else if !added(ctMonthNum) {
 					add(c.Value, ctMonthNum)
 				} else if !added(ctDay) {
 					add(c.Value, ctDay)
@@ -372,7 +505,9 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 					add(c.Value, ctNano)
 				}
 			case 2:
-				if prev.Value == ":" {
+				if pad := yearDayPad(c.Value); pad > 0 {
+					addYearDay(c.Value, pad)
+				} else if prev.Value == ":" {
 					if !added(ctMin) {
 						// has leading sign?
 						if rl >= 3 && isPlusMinus(result[rl-3].Value) {
@@ -427,7 +562,10 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 					add(c.Value, ctNano)
 				}
 			case 4:
-				if added(ctHour) && !added(ctTzHour) && plusminus {
+				if prev.Value == "T" && added(ctDay) && !added(ctHour) { // basic time, e.g. T1530
+					add(c.Value[0:2], ctHour)
+					add(c.Value[2:4], ctMin)
+				} else if added(ctHour) && !added(ctTzHour) && plusminus {
 					result[rl-1].Type = ctTzSign
 					componentsMap[ctTzSign] = rl - 1
 					add(c.Value[0:2], ctTzHour)
@@ -445,6 +583,28 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 				} else if isNanoSep(prev.Value) {
 					add(c.Value, ctNano)
 				}
+			case 3:
+				if !added(ctMonth) && yearDayPad(c.Value) == 0 {
+					addYearDay(c.Value, 0)
+				} else if isNanoSep(prev.Value) && !added(ctNano) {
+					add(c.Value, ctNano)
+				}
+			case 6:
+				if prev.Value == "T" && added(ctDay) && !added(ctHour) { // basic time, e.g. T153000
+					add(c.Value[0:2], ctHour)
+					add(c.Value[2:4], ctMin)
+					add(c.Value[4:6], ctSec)
+				} else if isNanoSep(prev.Value) && !added(ctNano) {
+					add(c.Value, ctNano)
+				}
+			case 7:
+				if rl > 0 && isNanoSep(prev.Value) && !added(ctNano) {
+					add(c.Value, ctNano)
+				} else if !added(ctYear) && !added(ctMonthNum) && !added(ctDay) && !added(ctHour) { // YYYYDDD
+					add(c.Value[0:4], ctYear)
+					rl = len(result)
+					addYearDay(c.Value[4:7], 0)
+				}
 			case 8:
 				if !added(ctYear) && !added(ctMonthNum) && !added(ctDay) {
 					add(c.Value[0:4], ctYear)
@@ -454,6 +614,24 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 					add(c.Value, ctNano)
 				}
 
+			case 10, 12:
+				if rl > 0 && isNanoSep(prev.Value) && !added(ctNano) {
+					add(c.Value, ctNano)
+				} else if !added(ctYear) && !added(ctMonthNum) && !added(ctDay) && !added(ctHour) {
+					// YYYYMMDDhh[mm]; anything outside 1900-2099 is more
+					// likely a Unix timestamp than a date and is left out
+					d.valueDependent = true
+					if y, _ := strconv.Atoi(c.Value[0:4]); y >= 1900 && y <= 2099 {
+						add(c.Value[0:4], ctYear)
+						add(c.Value[4:6], ctMonthNum)
+						add(c.Value[6:8], ctDay)
+						add(c.Value[8:10], ctHour)
+						if len(c.Value) == 12 {
+							add(c.Value[10:12], ctMin)
+						}
+					}
+				}
+
 			case 14:
 				if !added(ctYear) && !added(ctMonthNum) && !added(ctDay) {
 					add(c.Value[0:4], ctYear)
@@ -475,10 +653,18 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 		}
 
 		rl = len(result)
+		if rl == 0 {
+			continue
+		}
 		prev = result[rl-1]
 		plusminus = (prev.Value == "+" || prev.Value == "-")
 	}
 
+	if !hasComponent(result, ctYear, ctMonth, ctMonthNum, ctDay, ctYearDay, ctHour) {
+		return nil, ErrInvalidDateFormat
+	}
+	d.reorder(result, componentsMap)
+
 	// validate
 	month := 0
 	indexMonthNum := -1
@@ -488,7 +674,7 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 		if monthAdded {
 			v, err := strconv.Atoi(result[indexMonthNum].Value)
 			if err != nil {
-				return "", ErrInvalidDateFormat
+				return nil, ErrInvalidDateFormat
 			}
 			month = v
 		}
@@ -496,66 +682,101 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 
 	day := 0
 	indexDay, dayAdded := componentsMap[ctDay]
-	if dayAdded {
+	if dayAdded && result[indexDay].Type == ctDay {
 		v, err := strconv.Atoi(result[indexDay].Value)
 		if err != nil {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		if v < 1 || v > 31 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		day = v
 	}
 
-	if month != 0 && day != 0 {
-		if month > 12 && day <= 12 {
+	// months of a calendar are checked by Calendar.Date, as the Hebrew
+	// calendar has a thirteenth
+	if month != 0 && day != 0 && d.calendar == nil {
+		// an explicit order is kept even when the date does not fit it
+		if month > 12 && day <= 12 && d.order == OrderAuto {
+			d.valueDependent = true
 			result[indexMonthNum].Type = ctDay
 			result[indexDay].Type = ctMonthNum
 			month, day = day, month
 		}
 
 		if day < 1 || day > 31 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		if month < 1 || month > 12 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
+		}
+	}
+	for i := 1; i < len(result); i++ {
+		if result[i].Type != ctOrdinal {
+			continue
+		}
+		d.valueDependent = true
+		v, err := strconv.Atoi(result[i-1].Value)
+		if err != nil || !strings.EqualFold(result[i].Value, ordinalSuffix(v)) {
+			return nil, ErrInvalidDateFormat
+		}
+	}
+	yearDayIndex, yearDayAdded := componentsMap[ctYearDay]
+	if yearDayAdded {
+		d.valueDependent = true
+		v, err := strconv.Atoi(result[yearDayIndex].Value)
+		if err != nil {
+			return nil, ErrInvalidDateFormat
+		}
+		days := 366
+		if y, err := strconv.Atoi(result[componentsMap[ctYear]].Value); err == nil && len(result[componentsMap[ctYear]].Value) == 4 && !isLeap(y) {
+			days = 365
+		}
+		if v < 1 || v > days {
+			return nil, ErrInvalidDateFormat
 		}
 	}
 	hourIndex, hourAdded := componentsMap[ctHour]
 	if hourAdded {
 		v, err := strconv.Atoi(result[hourIndex].Value)
 		if err != nil {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		if v < 0 || v > 24 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 	}
 	minsIndex, minsAdded := componentsMap[ctMin]
 	if minsAdded {
 		v, err := strconv.Atoi(result[minsIndex].Value)
 		if err != nil {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		if v < 0 || v > 59 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 	}
 	secIndex, secAdded := componentsMap[ctSec]
 	if secAdded {
 		v, err := strconv.Atoi(result[secIndex].Value)
 		if err != nil {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 		if v < 0 || v > 59 {
-			return "", ErrInvalidDateFormat
+			return nil, ErrInvalidDateFormat
 		}
 	}
 
-	return d.goFmt(result), nil
+	d.padding(result)
+
+	return result, nil
 }
 
 // Attempts to detect the correct Go time layout format for parsing a given time string.
+// Inputs with non-ASCII digits are normalised first, so the layout parses
+// Normalize(input).Text rather than input itself. Ordinal suffixes are kept
+// as literals, so the layout of "November 22nd, 2024" is not reusable for
+// days with another suffix; Parse handles every ordinal day.
 // Parameters:
 //   - input: A string representing a date and/or time in various possible formats
 //
@@ -563,8 +784,30 @@ func (d *adDetector) extractPattern(input string) (string, error) {
 //   - A string representing the Go time layout that matches the input format
 //   - An error if the input format cannot be recognized or parsed
 func DetectFormat(input string) (string, error) {
-	input = strings.TrimSpace(input)
+	return detectLayout(input, nil)
+}
+
+// detectLayout is DetectFormat, looking up and storing generic layouts in
+// cache when it is not nil.
+func detectLayout(input string, cache *layoutCache) (string, error) {
+	input = strings.TrimSpace(Normalize(input).Text)
 
-	d := adDetector{}
-	return d.extractPattern(input)
+	if layout, ok := detectHTTPDate(input); ok {
+		return layout, nil
+	}
+	if _, ok := parseWeekDate(input); ok {
+		return "", ErrNoLayout
+	}
+	if _, ok, err := parseEraDate(input); ok {
+		if err != nil {
+			return "", err
+		}
+		return "", ErrNoLayout
+	}
+
+	if cache == nil {
+		d := adDetector{}
+		return d.extractPattern(input)
+	}
+	return cache.detect(input)
 }
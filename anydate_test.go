@@ -212,6 +212,37 @@ func TestAnyFormat(t *testing.T) {
 		{in: "2024-12-14T12:49:09.99999999Z", want: "2006-01-02T15:04:05.99999999Z"},
 		{in: "2024-12-14T12:59+0730", want: "2006-01-02T15:04-0700"},
 		{in: "Sat, 14 Dec 2024 3:3:3 PST", want: "Mon, 02 Jan 2006 3:4:5 MST"},
+		{in: "Nov  6 08:49:37", want: "Jan _2 15:04:05"},
+		{in: "Nov  6  2023", want: "Jan _2  2006"},
+		{in: "Tue Nov  5 08:04:06 UTC 2024", want: "Mon Jan _2 15:04:05 MST 2006"},
+		{in: "Nov   6 08:49", want: "Jan  _2 15:04"},
+		{in: "W20241124 15:04:05.123456", want: "W20060102 15:04:05.999999"},
+		{in: "2024-331", want: "2006-002"},
+		{in: "2024-060", want: "2006-002"},
+		{in: "2024331", want: "2006002"},
+		{in: "15:04:05.1234567", want: "15:04:05.9999999"},
+		{in: "10:00:00.1234567Z", want: "15:04:05.9999999Z"},
+		{in: "12:30:45.1234567 Nov 6 2024", want: "15:04:05.9999999 Jan 2 2006"},
+		{in: "2024-331T12:00:00Z", want: "2006-002T15:04:05Z"},
+		{in: "2024331T12:00Z", want: "2006002T15:04Z"},
+		{in: "2024 366 23:59", want: "2006 002 15:04"},
+		{in: "2024-  5", want: "2006-__2"},
+		{in: "2024- 31", want: "2006-__2"},
+		{in: "2024   5", want: "2006 __2"},
+		{in: "20241124T153000Z", want: "20060102T150405Z"},
+		{in: "20241124T153000.123+0900", want: "20060102T150405.999-0700"},
+		{in: "20241124T153000,5-0330", want: "20060102T150405,9-0700"},
+		{in: "20241124T1530Z", want: "20060102T1504Z"},
+		{in: "20241124T1530", want: "20060102T1504"},
+		{in: "20241124T15", want: "20060102T15"},
+		{in: "202411241530", want: "200601021504"},
+		{in: "2024112415", want: "2006010215"},
+		{in: "November 22nd, 2024", want: "January 02nd, 2006"},
+		{in: "November 1st, 2024", want: "January 2st, 2006"},
+		{in: "Nov 11th 2024 10:00", want: "Jan 02th 2006 15:04"},
+		{in: "23rd March 2024", want: "02rd January 2006"},
+		{in: "the 3rd of March", want: "the 2rd of January"},
+		{in: "Tuesday, the 12TH of November 2024", want: "Monday, the 02TH of January 2006"},
 	}
 
 	for _, tt := range tests {
@@ -233,6 +264,28 @@ func TestAnyFormat(t *testing.T) {
 	}
 }
 
+// Fractions longer than nanoseconds still get a layout, though time.Parse
+// cannot round-trip them.
+func TestAnyFormatLongFraction(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "15:04:05.1234567890", want: "15:04:05.0000000000"},
+		{in: "10:00:00.123456789012Z", want: "15:04:05.999999999999Z"},
+	}
+
+	for _, tt := range tests {
+		got, err := DetectFormat(tt.in)
+		if err != nil {
+			t.Errorf("AnyFormat(\"%s\") failed with %s", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("AnyFormat(\"%s\") = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestAnyFormatErr(t *testing.T) {
 	tests := []struct {
 		in   string
@@ -244,6 +297,23 @@ func TestAnyFormatErr(t *testing.T) {
 		{in: "25:01"},
 		{in: "4:60"},
 		{in: "4:35:60"},
+		{in: "2023-366"},
+		{in: "2024-367"},
+		{in: "2024-000"},
+		{in: "2023366"},
+		{in: "November 22st, 2024"},
+		{in: "the 11st of March"},
+		{in: "March 3th"},
+		{in: "1732460400"},
+		{in: "173246040012"},
+		{in: "2024133115"},
+		{in: "2024112425"},
+		{in: "20241124T253000Z"},
+		{in: "45620"},
+		{in: "12345"},
+		{in: "123"},
+		{in: "Z"},
+		{in: "hello world"},
 	}
 
 	for _, tt := range tests {
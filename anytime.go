@@ -0,0 +1,101 @@
+package goanydate
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Time is a time.Time that unmarshals from any format the detector
+// understands and remembers the layout it was written in. It implements
+// json.Marshaler, encoding.TextMarshaler, sql.Scanner and driver.Valuer and
+// their counterparts.
+type Time struct {
+	time.Time
+	// Layout is the detected layout, empty for formats without one.
+	Layout string
+	// YearInferred reports that the input had no year, as in "Nov 24".
+	YearInferred bool
+	// MarshalLayout, when set, is the layout t is marshalled in. It is kept
+	// by the Unmarshal and Scan methods, so it can be set on a field before
+	// decoding into it.
+	MarshalLayout string
+}
+
+// layout returns MarshalLayout when set. Otherwise t is written in its
+// detected layout, or in RFC 3339 when it has none or its year was inferred,
+// since the detected layout would drop the year.
+func (t Time) layout() string {
+	switch {
+	case t.MarshalLayout != "":
+		return t.MarshalLayout
+	case t.Layout != "" && !t.YearInferred:
+		return t.Layout
+	}
+	return time.RFC3339Nano
+}
+
+// String formats t like MarshalText.
+func (t Time) String() string {
+	return t.Format(t.layout())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any format Parse accepts.
+func (t *Time) UnmarshalText(b []byte) error {
+	p := Parser{}
+	r, err := p.ParseResult(string(b))
+	if err != nil {
+		return err
+	}
+	*t = Time{Time: r.Time, Layout: r.Layout, YearInferred: r.YearInferred, MarshalLayout: t.MarshalLayout}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves t unchanged.
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner. Strings are parsed like UnmarshalText and
+// NULL scans as the zero Time.
+func (t *Time) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{MarshalLayout: t.MarshalLayout}
+	case time.Time:
+		*t = Time{Time: v, MarshalLayout: t.MarshalLayout}
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	default:
+		return fmt.Errorf("goanydate: cannot scan %T into Time", src)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer. The zero Time is stored as NULL.
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
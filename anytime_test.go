@@ -0,0 +1,127 @@
+package goanydate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+		out  string
+	}{
+		{in: `"2024-11-24 15:04:05"`, want: time.Date(2024, time.November, 24, 15, 4, 5, 0, time.UTC), out: `"2024-11-24 15:04:05"`},
+		{in: `"Nov 24, 2024"`, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC), out: `"Nov 24, 2024"`},
+		{in: `"2024-W47-7"`, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC), out: `"2024-11-24T00:00:00Z"`},
+		{in: `"2024-11-24T15:04:05.123+09:00"`, want: time.Date(2024, time.November, 24, 6, 4, 5, 123000000, time.UTC), out: `"2024-11-24T15:04:05.123+09:00"`},
+	}
+
+	for _, tt := range tests {
+		var got Time
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got.Time, tt.want)
+		}
+		out, err := json.Marshal(got)
+		if err != nil || string(out) != tt.out {
+			t.Errorf("Marshal(%s) = %s, %v, want %s", tt.in, out, err, tt.out)
+		}
+	}
+}
+
+func TestTimeJSONErr(t *testing.T) {
+	for _, in := range []string{`"not a date"`, `20241124`, `{}`} {
+		var got Time
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want error", in, got)
+		}
+	}
+
+	got := Time{Time: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)}
+	if err := json.Unmarshal([]byte("null"), &got); err != nil || got.IsZero() {
+		t.Errorf("Unmarshal(null) = %v, %v, want unchanged", got, err)
+	}
+}
+
+func TestTimeMarshalLayout(t *testing.T) {
+	var v struct {
+		At    Time `json:"at"`
+		Other Time `json:"other"`
+	}
+	v.At.MarshalLayout = time.DateOnly
+	if err := json.Unmarshal([]byte(`{"at":"24 November 2024 10:00","other":"24 November 2024 10:00"}`), &v); err != nil {
+		t.Fatalf("Unmarshal failed with %s", err)
+	}
+	out, _ := json.Marshal(v)
+	if string(out) != `{"at":"2024-11-24","other":"24 November 2024 10:00"}` {
+		t.Errorf("Marshal = %s, want {\"at\":\"2024-11-24\",\"other\":\"24 November 2024 10:00\"}", out)
+	}
+	if v.At.Layout != "02 January 2006 15:04" {
+		t.Errorf("Layout = %s, want 02 January 2006 15:04", v.At.Layout)
+	}
+}
+
+func TestTimeYearInferred(t *testing.T) {
+	var got Time
+	if err := got.UnmarshalText([]byte("Nov 24")); err != nil {
+		t.Fatalf("UnmarshalText failed with %s", err)
+	}
+	if !got.YearInferred {
+		t.Errorf("YearInferred = false, want true")
+	}
+	want := got.Time.Format(time.RFC3339Nano)
+	if out, _ := got.MarshalText(); string(out) != want {
+		t.Errorf("MarshalText = %s, want %s", out, want)
+	}
+}
+
+func TestTimeText(t *testing.T) {
+	var got Time
+	if err := got.UnmarshalText([]byte("12/Dec/2024:16:36:17 -0700")); err != nil {
+		t.Fatalf("UnmarshalText failed with %s", err)
+	}
+	if out, _ := got.MarshalText(); string(out) != "12/Dec/2024:16:36:17 -0700" {
+		t.Errorf("MarshalText = %s, want 12/Dec/2024:16:36:17 -0700", out)
+	}
+}
+
+func TestTimeSQL(t *testing.T) {
+	want := time.Date(2024, time.November, 24, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		src  any
+		want time.Time
+	}{
+		{src: "2024-11-24 15:04:05", want: want},
+		{src: []byte("24/11/2024 15:04:05"), want: want},
+		{src: want, want: want},
+		{src: nil},
+	}
+
+	for _, tt := range tests {
+		var got Time
+		if err := got.Scan(tt.src); err != nil {
+			t.Errorf("Scan(%v) failed with %s", tt.src, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Scan(%v) = %v, want %v", tt.src, got.Time, tt.want)
+		}
+		v, err := got.Value()
+		if err != nil {
+			t.Errorf("Value() failed with %s", err)
+		}
+		if tt.want.IsZero() && v != nil || !tt.want.IsZero() && v != tt.want {
+			t.Errorf("Value() = %v, want %v", v, tt.want)
+		}
+	}
+
+	var got Time
+	if err := got.Scan(42); err == nil {
+		t.Errorf("Scan(42) = %v, want error", got)
+	}
+}
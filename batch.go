@@ -0,0 +1,222 @@
+package goanydate
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// BatchOptions configures DetectBatch and DetectStream.
+type BatchOptions struct {
+	// Workers bounds the inputs detected at once. Defaults to GOMAXPROCS.
+	Workers int
+	// CacheSize bounds the layouts remembered by input shape. Defaults to
+	// 4096; a negative size disables the cache.
+	CacheSize int
+}
+
+// BatchResult is the outcome of DetectFormat for one input.
+type BatchResult struct {
+	// Index is the position of the input in the batch or stream.
+	Index  int
+	Input  string
+	Layout string
+	Err    error
+}
+
+func (o BatchOptions) workers() int {
+	if o.Workers > 0 {
+		return o.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// DetectBatch runs DetectFormat on every input concurrently and returns the
+// results in input order. Inputs of the same shape, such as "2024-11-24" and
+// "2023-01-05", share a cached layout once it is confirmed to parse them.
+// When ctx is cancelled, DetectBatch stops and returns ctx.Err(), with the
+// unprocessed results carrying it too.
+func DetectBatch(ctx context.Context, inputs []string, opts BatchOptions) ([]BatchResult, error) {
+	results := make([]BatchResult, len(inputs))
+	done := make([]bool, len(inputs))
+	cache := newLayoutCache(opts.CacheSize)
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < opts.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(inputs) {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				layout, err := detectLayout(inputs[i], cache)
+				results[i] = BatchResult{Index: i, Input: inputs[i], Layout: layout, Err: err}
+				done[i] = true
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if !done[i] {
+				results[i] = BatchResult{Index: i, Input: inputs[i], Err: err}
+			}
+		}
+		return results, err
+	}
+	return results, nil
+}
+
+// DetectStream runs DetectFormat concurrently on the inputs received and
+// sends the results, in input order, on the returned channel. The channel is
+// closed once inputs is closed and drained, or when ctx is cancelled.
+func DetectStream(ctx context.Context, inputs <-chan string, opts BatchOptions) <-chan BatchResult {
+	workers := opts.workers()
+	cache := newLayoutCache(opts.CacheSize)
+
+	type job struct {
+		index int
+		input string
+	}
+	jobs := make(chan job)
+	results := make(chan BatchResult, workers)
+	out := make(chan BatchResult, workers)
+	// window bounds the results held back to restore the input order
+	window := make(chan struct{}, workers*4)
+
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			var in string
+			select {
+			case <-ctx.Done():
+				return
+			case s, ok := <-inputs:
+				if !ok {
+					return
+				}
+				in = s
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+			jobs <- job{index: i, input: in}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				layout, err := detectLayout(j.input, cache)
+				results <- BatchResult{Index: j.index, Input: j.input, Layout: layout, Err: err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(out)
+		pending := map[int]BatchResult{}
+		next := 0
+		for r := range results {
+			pending[r.Index] = r
+			for {
+				p, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				if ctx.Err() == nil {
+					select {
+					case out <- p:
+					case <-ctx.Done():
+					}
+				}
+				<-window
+			}
+		}
+	}()
+
+	return out
+}
+
+// layoutCache maps input shapes to the layout DetectFormat found for them.
+type layoutCache struct {
+	mu      sync.RWMutex
+	layouts map[string]string
+	size    int
+}
+
+func newLayoutCache(size int) *layoutCache {
+	if size == 0 {
+		size = 4096
+	}
+	return &layoutCache{layouts: map[string]string{}, size: size}
+}
+
+// shape replaces the digits of input by 0 and 1 for zero and non-zero, which
+// is all the generic detector looks at outside of value-dependent rules.
+func shape(input string) string {
+	b := []byte(input)
+	for i, c := range b {
+		if c >= '1' && c <= '9' {
+			b[i] = '1'
+		}
+	}
+	return string(b)
+}
+
+// detect runs the generic detector on a normalised input, through the cache.
+// A cached layout is only used when it parses the input, and layouts that
+// depend on the digits, such as day-first dates chosen because the month
+// would be over 12, are never stored.
+func (c *layoutCache) detect(input string) (string, error) {
+	if c.size < 0 {
+		d := adDetector{}
+		return d.extractPattern(input)
+	}
+
+	key := shape(input)
+	c.mu.RLock()
+	layout, ok := c.layouts[key]
+	c.mu.RUnlock()
+	if ok {
+		if _, err := time.Parse(layout, input); err == nil {
+			return layout, nil
+		}
+	}
+
+	d := adDetector{}
+	layout, err := d.extractPattern(input)
+	if err != nil || d.valueDependent {
+		return layout, err
+	}
+	c.mu.Lock()
+	if len(c.layouts) >= c.size {
+		clear(c.layouts)
+	}
+	c.layouts[key] = layout
+	c.mu.Unlock()
+
+	return layout, nil
+}
@@ -0,0 +1,118 @@
+package goanydate
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+var batchInputs = []string{
+	"2024-11-24",
+	"2023-01-05",
+	"03/04/2024",
+	"13/04/2024",
+	"03/14/2024",
+	"14/13/2024",
+	"2024-02-30",
+	"2024-11-24 15:04:05.120",
+	"2024-11-24 15:04:05.123",
+	"November 22nd, 2024",
+	"November 21st, 2024",
+	"November 22st, 2024",
+	"2024112415",
+	"1732460400",
+	"2024-366",
+	"2023-366",
+	"Sun, 06 Nov 1994 08:49:37 GMT",
+	"2024-W47-7",
+	"R6.11.24",
+	"not a date",
+	"٢٠٢٤-١١-٢٤",
+	"Nov  6 08:49:37",
+	"Nov 16 08:49:37",
+}
+
+func TestDetectBatch(t *testing.T) {
+	var inputs []string
+	for i := 0; i < 20; i++ {
+		inputs = append(inputs, batchInputs...)
+	}
+
+	for _, opts := range []BatchOptions{{}, {Workers: 1}, {Workers: 8, CacheSize: 2}, {CacheSize: -1}} {
+		results, err := DetectBatch(context.Background(), inputs, opts)
+		if err != nil {
+			t.Fatalf("DetectBatch(%+v) failed with %s", opts, err)
+		}
+		if len(results) != len(inputs) {
+			t.Fatalf("DetectBatch(%+v) returned %d results, want %d", opts, len(results), len(inputs))
+		}
+		for i, r := range results {
+			layout, err := DetectFormat(inputs[i])
+			if r.Index != i || r.Input != inputs[i] || r.Layout != layout || !errors.Is(r.Err, err) {
+				t.Errorf("DetectBatch(%+v)[%d] = %+v, want %s, %v", opts, i, r, layout, err)
+			}
+		}
+	}
+}
+
+func TestDetectBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := DetectBatch(ctx, batchInputs, BatchOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("DetectBatch error = %v, want context.Canceled", err)
+	}
+	for i, r := range results {
+		if r.Index != i || r.Input != batchInputs[i] || !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("DetectBatch[%d] = %+v, want context.Canceled", i, r)
+		}
+	}
+}
+
+func TestDetectStream(t *testing.T) {
+	inputs := make(chan string)
+	go func() {
+		defer close(inputs)
+		for i := 0; i < 10; i++ {
+			for _, in := range batchInputs {
+				inputs <- in
+			}
+		}
+	}()
+
+	n := 0
+	for r := range DetectStream(context.Background(), inputs, BatchOptions{Workers: 4}) {
+		in := batchInputs[n%len(batchInputs)]
+		layout, err := DetectFormat(in)
+		if r.Index != n || r.Input != in || r.Layout != layout || !errors.Is(r.Err, err) {
+			t.Errorf("DetectStream result %d = %+v, want %s, %v", n, r, layout, err)
+		}
+		n++
+	}
+	if n != 10*len(batchInputs) {
+		t.Errorf("DetectStream returned %d results, want %d", n, 10*len(batchInputs))
+	}
+}
+
+func TestDetectStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	inputs := make(chan string)
+	go func() {
+		for {
+			select {
+			case inputs <- "2024-11-24":
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := DetectStream(ctx, inputs, BatchOptions{Workers: 2})
+	for i := 0; i < 5; i++ {
+		<-out
+	}
+	cancel()
+	for range out {
+	}
+}
@@ -0,0 +1,413 @@
+package goanydate
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Calendar is a non-Gregorian calendar. When Parser.Calendar is set, the
+// year, month and day found by the detector are read in that calendar and
+// converted to a Gregorian time.Time.
+type Calendar interface {
+	// Month returns the number of a month name, native or romanised.
+	Month(name string) (int, bool)
+	// Date returns the Gregorian date, at midnight UTC, of a date in the calendar.
+	Date(year, month, day int) (time.Time, error)
+}
+
+// Calendars available for Parser.Calendar.
+var (
+	// Hijri is the tabular Islamic calendar. It can differ by a day from
+	// calendars based on moon sighting such as Umm al-Qura.
+	Hijri Calendar = newNamedCalendar(hijriDate, hijriMonths)
+	// Persian is the Solar Hijri (Jalali) calendar of Iran and Afghanistan,
+	// using the 33-year arithmetic leap year rule.
+	Persian Calendar = newNamedCalendar(persianDate, persianMonths)
+	// Hebrew is the Hebrew calendar. Months are numbered from Nisan, so
+	// Tishrei is 7 and Adar II, in leap years, is 13.
+	Hebrew Calendar = newNamedCalendar(hebrewDate, hebrewMonths)
+	// ThaiBuddhist is the Thai solar calendar: Gregorian months and days,
+	// years counted from 543 BC.
+	ThaiBuddhist Calendar = newNamedCalendar(thaiDate, thaiMonths)
+)
+
+var hijriMonths = [][]string{
+	{"Muharram", "محرم"},
+	{"Safar", "صفر"},
+	{"Rabi al-Awwal", "Rabi I", "ربيع الأول"},
+	{"Rabi al-Thani", "Rabi al-Akhir", "Rabi II", "ربيع الآخر", "ربيع الثاني"},
+	{"Jumada al-Awwal", "Jumada al-Ula", "Jumada I", "جمادى الأولى"},
+	{"Jumada al-Thani", "Jumada al-Akhirah", "Jumada II", "جمادى الآخرة"},
+	{"Rajab", "رجب"},
+	{"Shaban", "Sha'ban", "شعبان"},
+	{"Ramadan", "رمضان"},
+	{"Shawwal", "شوال"},
+	{"Dhu al-Qadah", "Dhu al-Qi'dah", "ذو القعدة"},
+	{"Dhu al-Hijjah", "ذو الحجة"},
+}
+
+var persianMonths = [][]string{
+	{"Farvardin", "فروردین"},
+	{"Ordibehesht", "اردیبهشت"},
+	{"Khordad", "خرداد"},
+	{"Tir", "تیر"},
+	{"Mordad", "Amordad", "مرداد", "امرداد"},
+	{"Shahrivar", "شهریور"},
+	{"Mehr", "مهر"},
+	{"Aban", "آبان"},
+	{"Azar", "آذر"},
+	{"Dey", "دی"},
+	{"Bahman", "بهمن"},
+	{"Esfand", "اسفند"},
+}
+
+var hebrewMonths = [][]string{
+	{"Nisan", "ניסן"},
+	{"Iyar", "Iyyar", "אייר"},
+	{"Sivan", "סיון", "סיוון"},
+	{"Tammuz", "Tamuz", "תמוז"},
+	{"Av", "אב"},
+	{"Elul", "אלול"},
+	{"Tishrei", "Tishri", "תשרי"},
+	{"Cheshvan", "Heshvan", "Marcheshvan", "חשון", "חשוון"},
+	{"Kislev", "כסלו"},
+	{"Tevet", "טבת"},
+	{"Shevat", "שבט"},
+	{"Adar", "Adar I", "אדר", "אדר א"},
+	{"Adar II", "אדר ב"},
+}
+
+var thaiMonths = [][]string{
+	{"มกราคม", "ม.ค."},
+	{"กุมภาพันธ์", "ก.พ."},
+	{"มีนาคม", "มี.ค."},
+	{"เมษายน", "เม.ย."},
+	{"พฤษภาคม", "พ.ค."},
+	{"มิถุนายน", "มิ.ย."},
+	{"กรกฎาคม", "ก.ค."},
+	{"สิงหาคม", "ส.ค."},
+	{"กันยายน", "ก.ย."},
+	{"ตุลาคม", "ต.ค."},
+	{"พฤศจิกายน", "พ.ย."},
+	{"ธันวาคม", "ธ.ค."},
+}
+
+// namedCalendar matches month names for a calendar conversion function.
+type namedCalendar struct {
+	date   func(year, month, day int) (time.Time, error)
+	months [][]string
+	// names holds every name containing separators, longest first, so
+	// that calendarCompact can fuse them into a single letter chunk.
+	names []string
+}
+
+func newNamedCalendar(date func(int, int, int) (time.Time, error), months [][]string) namedCalendar {
+	c := namedCalendar{date: date, months: months}
+	for _, names := range months {
+		for _, n := range names {
+			if compactName(n) != n {
+				c.names = append(c.names, n)
+			}
+		}
+	}
+	sort.Slice(c.names, func(i, j int) bool { return len(c.names[i]) > len(c.names[j]) })
+	return c
+}
+
+func (c namedCalendar) Month(name string) (int, bool) {
+	name = compactName(name)
+	for i, names := range c.months {
+		for _, n := range names {
+			if strings.EqualFold(name, compactName(n)) {
+				return i + 1, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func (c namedCalendar) Date(year, month, day int) (time.Time, error) {
+	return c.date(year, month, day)
+}
+
+// compactName drops everything but letters from a month name, so that
+// "Rabi al-Awwal" and "ربيع الأول" become single words.
+func compactName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsMark(r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// calendarCompact rewrites multi-word month names of c in input as single words.
+func calendarCompact(c Calendar, input string) string {
+	nc, ok := c.(namedCalendar)
+	if !ok {
+		return input
+	}
+	for _, n := range nc.names {
+		for {
+			i := strings.Index(strings.ToLower(input), strings.ToLower(n))
+			if i < 0 || len(strings.ToLower(input)) != len(input) {
+				break
+			}
+			input = input[:i] + compactName(n) + input[i+len(n):]
+		}
+	}
+	return input
+}
+
+// parseCalendar reads the detected year, month and day of input in p.Calendar.
+// A time zone in the input takes precedence over p.Location.
+func (p *Parser) parseCalendar(input string) (Result, error) {
+	input = calendarCompact(p.Calendar, input)
+	d := adDetector{calendar: p.Calendar, order: p.Order}
+	components, err := d.extractComponents(input)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var year, month, day, hour, min, sec, nsec, tzHour, tzMin int
+	var tzSign, tzAbbr string
+	pm, am := false, false
+	for _, c := range components {
+		v, _ := atoiPrefix(c.Value)
+		switch c.Type {
+		case ctYear:
+			if len(c.Value) < 3 {
+				return Result{}, ErrInvalidDateFormat
+			}
+			year = v
+		case ctMonth:
+			if m, ok := p.Calendar.Month(c.Value); ok {
+				month = m
+			} else {
+				month = monthIndex(c.Value) + 1
+			}
+		case ctMonthNum:
+			month = v
+		case ctDay:
+			day = v
+		case ctHour:
+			hour = v
+		case ctMin:
+			min = v
+		case ctSec:
+			sec = v
+		case ctNano:
+			nsec = v
+			for i := len(c.Value); i < 9; i++ {
+				nsec *= 10
+			}
+		case ctAmPm:
+			pm = strings.EqualFold(c.Value, "pm")
+			am = !pm
+		case ctTzSign:
+			tzSign = c.Value
+		case ctTzHour:
+			tzHour = v
+		case ctTzMin:
+			tzMin = v
+		case ctTzAbbr:
+			tzAbbr = c.Value
+		}
+	}
+	if year == 0 || month < 1 || day == 0 {
+		return Result{}, ErrInvalidDateFormat
+	}
+	if pm && hour < 12 {
+		hour += 12
+	} else if am && hour == 12 {
+		hour = 0
+	}
+
+	t, err := p.Calendar.Date(year, month, day)
+	if err != nil {
+		return Result{}, err
+	}
+	t = time.Date(t.Year(), t.Month(), t.Day(), hour, min, sec, nsec, p.location())
+
+	switch {
+	case tzSign == "Z":
+		t = time.Date(t.Year(), t.Month(), t.Day(), hour, min, sec, nsec, time.UTC)
+	case tzSign != "":
+		offset := (tzHour*60 + tzMin) * 60
+		if tzSign == "-" {
+			offset = -offset
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day(), hour, min, sec, nsec, time.FixedZone("", offset))
+	case strings.EqualFold(tzAbbr, "UTC") || strings.EqualFold(tzAbbr, "GMT"):
+		t = time.Date(t.Year(), t.Month(), t.Day(), hour, min, sec, nsec, time.UTC)
+	case tzAbbr != "":
+		// other abbreviations are ambiguous, so only the one of p.Location
+		// at that time is accepted
+		if name, _ := t.Zone(); !strings.EqualFold(name, tzAbbr) {
+			return Result{}, ErrInvalidDateFormat
+		}
+	}
+	return Result{Time: t}, nil
+}
+
+func atoiPrefix(v string) (int, bool) {
+	n := 0
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			return n, i > 0
+		}
+		n = n*10 + int(v[i]-'0')
+	}
+	return n, len(v) > 0
+}
+
+var unixEpochJDN = 2440588 // Julian day number of 1970-01-01
+
+func fromJDN(jdn int) time.Time {
+	return time.Unix(0, 0).UTC().AddDate(0, 0, jdn-unixEpochJDN)
+}
+
+func hijriLeap(year int) bool {
+	return (14+11*year)%30 < 11
+}
+
+func hijriDate(year, month, day int) (time.Time, error) {
+	if year < 1 || month < 1 || month > 12 || day < 1 {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	days := 30 - (month+1)%2
+	if month == 12 && hijriLeap(year) {
+		days = 30
+	}
+	if day > days {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+
+	jdn := day + (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + 1948439
+	return fromJDN(jdn), nil
+}
+
+func persianLeap(year int) bool {
+	return (25*year+11)%33 < 8
+}
+
+// nowruz1403 is 1 Farvardin 1403.
+var nowruz1403 = time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+func persianDate(year, month, day int) (time.Time, error) {
+	if year < 1 || month < 1 || month > 12 || day < 1 {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	days := 31
+	switch {
+	case month == 12 && persianLeap(year):
+		days = 30
+	case month == 12:
+		days = 29
+	case month > 6:
+		days = 30
+	}
+	if day > days {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+
+	offset := 0
+	for y := 1403; y < year; y++ {
+		offset += 365
+		if persianLeap(y) {
+			offset++
+		}
+	}
+	for y := year; y < 1403; y++ {
+		offset -= 365
+		if persianLeap(y) {
+			offset--
+		}
+	}
+	if month <= 7 {
+		offset += (month - 1) * 31
+	} else {
+		offset += 186 + (month-7)*30
+	}
+	return nowruz1403.AddDate(0, 0, offset+day-1), nil
+}
+
+// The Hebrew calendar follows Calendrical Calculations by Reingold and
+// Dershowitz, counting days from 0001-01-01 (fixed date 1).
+const hebrewEpoch = -1373427
+
+func hebrewLeap(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	days := 29*months + parts/25920
+	if (3*(days+1))%7 < 3 {
+		days++
+	}
+	return days
+}
+
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	if ny2-ny1 == 356 {
+		correction = 2
+	} else if ny1-ny0 == 382 {
+		correction = 1
+	}
+	return hebrewEpoch + ny1 + correction
+}
+
+func hebrewMonthDays(year, month int) int {
+	yearDays := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeap(year):
+		return 29
+	case month == 8 && yearDays%10 != 5: // long Cheshvan in 355 and 385 day years
+		return 29
+	case month == 9 && yearDays%10 == 3: // short Kislev in 353 and 383 day years
+		return 29
+	}
+	return 30
+}
+
+func hebrewDate(year, month, day int) (time.Time, error) {
+	last := 12
+	if hebrewLeap(year) {
+		last = 13
+	}
+	if year < 1 || month < 1 || month > last || day < 1 || day > hebrewMonthDays(year, month) {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+
+	// the year starts in Tishrei, the seventh month
+	fixed := hebrewNewYear(year) + day - 1
+	if month < 7 {
+		for m := 7; m <= last; m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+		for m := 1; m < month; m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+	}
+	return time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, fixed-1), nil
+}
+
+func thaiDate(year, month, day int) (time.Time, error) {
+	t := time.Date(year-543, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if year <= 543 || month < 1 || month > 12 || t.Day() != day {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	return t, nil
+}
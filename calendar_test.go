@@ -0,0 +1,142 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCalendar(t *testing.T) {
+	tests := []struct {
+		in       string
+		calendar Calendar
+		want     time.Time
+	}{
+		{in: "1403/09/06", calendar: Persian, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "1404-01-01", calendar: Persian, want: time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		{in: "1399-01-01", calendar: Persian, want: time.Date(2020, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{in: "1403/12/30", calendar: Persian, want: time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{in: "6 Azar 1403", calendar: Persian, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "۶ آذر ۱۴۰۳", calendar: Persian, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "6 آذر 1403", calendar: Persian, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "1403/09/06 14:30", calendar: Persian, want: time.Date(2024, time.November, 26, 14, 30, 0, 0, time.UTC)},
+		{in: "1445-09-01", calendar: Hijri, want: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{in: "1 Ramadan 1445", calendar: Hijri, want: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{in: "1 رمضان 1445", calendar: Hijri, want: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{in: "1 Muharram 1446", calendar: Hijri, want: time.Date(2024, time.July, 8, 0, 0, 0, 0, time.UTC)},
+		{in: "12 Rabi al-Awwal 1446", calendar: Hijri, want: time.Date(2024, time.September, 16, 0, 0, 0, 0, time.UTC)},
+		{in: "10 Dhu al-Hijjah 1445", calendar: Hijri, want: time.Date(2024, time.June, 17, 0, 0, 0, 0, time.UTC)},
+		{in: "1 Tishrei 5785", calendar: Hebrew, want: time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC)},
+		{in: "15 Nisan 5784", calendar: Hebrew, want: time.Date(2024, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{in: "14 Adar II 5784", calendar: Hebrew, want: time.Date(2024, time.March, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "5784-13-05", calendar: Hebrew, want: time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{in: "5784/13/15", calendar: Hebrew, want: time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC)},
+		{in: "14 Adar 5785", calendar: Hebrew, want: time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{in: "25 Kislev 5785", calendar: Hebrew, want: time.Date(2024, time.December, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "1 תשרי 5785", calendar: Hebrew, want: time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC)},
+		{in: "2567-11-24", calendar: ThaiBuddhist, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "24 พฤศจิกายน 2567", calendar: ThaiBuddhist, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "24 พ.ย. 2567", calendar: ThaiBuddhist, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "1 กุมภาพันธ์ 2567", calendar: ThaiBuddhist, want: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "Nov 24, 2567", calendar: ThaiBuddhist, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Calendar: tt.calendar}
+		got, err := p.Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCalendarZone(t *testing.T) {
+	tehran, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Skip(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	tests := []struct {
+		in   string
+		loc  *time.Location
+		want time.Time
+	}{
+		{in: "1403-09-06T14:30:00Z", loc: tehran, want: time.Date(2024, time.November, 26, 14, 30, 0, 0, time.UTC)},
+		{in: "1403-09-06T14:30:00+03:30", loc: time.UTC, want: time.Date(2024, time.November, 26, 11, 0, 0, 0, time.UTC)},
+		{in: "1403-09-06T14:30:00-0500", loc: tehran, want: time.Date(2024, time.November, 26, 19, 30, 0, 0, time.UTC)},
+		{in: "1403/09/06 14:30 UTC", loc: tehran, want: time.Date(2024, time.November, 26, 14, 30, 0, 0, time.UTC)},
+		{in: "1403/09/06 14:30 EST", loc: newYork, want: time.Date(2024, time.November, 26, 19, 30, 0, 0, time.UTC)},
+		{in: "1403/09/06 14:30", loc: tehran, want: time.Date(2024, time.November, 26, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Calendar: Persian, Location: tt.loc}
+		got, err := p.Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	// an abbreviation that is not the one of Location has no known offset
+	p := Parser{Calendar: Persian, Location: tehran}
+	if got, err := p.Parse("1403/09/06 14:30 EST"); err == nil {
+		t.Errorf("Parse(\"1403/09/06 14:30 EST\") = %v, want error", got)
+	}
+}
+
+func TestParseCalendarErr(t *testing.T) {
+	tests := []struct {
+		in       string
+		calendar Calendar
+	}{
+		{in: "1402/12/30", calendar: Persian},
+		{in: "1403/07/31", calendar: Persian},
+		{in: "Azar 1403", calendar: Persian},
+		{in: "1445-02-30", calendar: Hijri},
+		{in: "1 Adar II 5785", calendar: Hebrew},
+		{in: "30 Cheshvan 5784", calendar: Hebrew},
+		{in: "5785-13-05", calendar: Hebrew},
+		{in: "1403-13-05", calendar: Persian},
+		{in: "2567-02-30", calendar: ThaiBuddhist},
+		{in: "03/09/06", calendar: Persian},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Calendar: tt.calendar}
+		if got, err := p.Parse(tt.in); err == nil {
+			t.Errorf("Parse(\"%s\") = %v, want error", tt.in, got)
+		}
+	}
+}
+
+func TestCalendarMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		want     int
+	}{
+		{name: "farvardin", calendar: Persian, want: 1},
+		{name: "اسفند", calendar: Persian, want: 12},
+		{name: "Sha'ban", calendar: Hijri, want: 8},
+		{name: "Jumada II", calendar: Hijri, want: 6},
+		{name: "Adar I", calendar: Hebrew, want: 12},
+		{name: "Adar II", calendar: Hebrew, want: 13},
+		{name: "ธ.ค.", calendar: ThaiBuddhist, want: 12},
+	}
+
+	for _, tt := range tests {
+		if got, ok := tt.calendar.Month(tt.name); !ok || got != tt.want {
+			t.Errorf("Month(\"%s\") = %d, %t, want %d", tt.name, got, ok, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Command anydate serves date detection and parsing over HTTP.
+//
+// Usage:
+//
+//	anydate serve [-addr localhost:8080]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/nodivbyzero/go-anydate/server"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "serve":
+		fs := flag.NewFlagSet("serve", flag.ExitOnError)
+		addr := fs.String("addr", "localhost:8080", "address to listen on")
+		fs.Parse(os.Args[2:])
+
+		log.Printf("anydate listening on %s", *addr)
+		srv := &http.Server{
+			Addr:              *addr,
+			Handler:           server.New(server.Options{}),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		log.Fatal(srv.ListenAndServe())
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: anydate serve [-addr host:port]")
+	os.Exit(2)
+}
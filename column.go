@@ -0,0 +1,146 @@
+package goanydate
+
+import (
+	"strings"
+	"time"
+)
+
+// Column describes the date format shared by the values of a column, as
+// found by InferColumn.
+type Column struct {
+	// Layout is the Go layout of the values, empty for numeric encodings.
+	Layout string
+	// Numeric is the numeric encoding of the values, if any.
+	Numeric NumericFormat
+	// Matched counts the sampled values in the format out of Total non-empty ones.
+	Matched, Total int
+
+	parser   Parser
+	yearless bool
+}
+
+// Serial numbers between 1950 and 2100 are taken for Excel dates by column
+// inference even when no numeric format is enabled.
+const (
+	excelColumnMin = 18264 // 1950-01-01
+	excelColumnMax = 73050 // 2099-12-31
+)
+
+// InferColumn finds the date format of a column with the zero Parser.
+func InferColumn(values []string) (Column, error) {
+	p := Parser{}
+	return p.InferColumn(values)
+}
+
+// InferColumn finds the date format shared by a sample of values from one
+// column. At least nine in ten non-empty values must be in the format,
+// otherwise ErrInvalidDateFormat is returned.
+//
+// Ambiguous values are settled by the rest of the column: "03/04/2024" in a
+// column that also holds "14/03/2024" is read as day first. A column of plain
+// numbers is read in the parser's numeric encodings or, when none is enabled,
+// as Excel 1900 serial dates if every value falls between 1950 and 2100.
+func (p *Parser) InferColumn(values []string) (Column, error) {
+	var sample []string
+	for _, v := range values {
+		if v = strings.TrimSpace(Normalize(v).Text); v != "" {
+			sample = append(sample, v)
+		}
+	}
+	if len(sample) == 0 {
+		return Column{}, ErrInvalidDateFormat
+	}
+
+	c := p.inferLayout(sample)
+	if n := p.inferNumeric(sample); n.Matched > c.Matched {
+		c = n
+	}
+	if c.Matched*10 < len(sample)*9 {
+		return Column{}, ErrInvalidDateFormat
+	}
+
+	return c, nil
+}
+
+// inferLayout picks the detected layout that parses the most values.
+func (p *Parser) inferLayout(sample []string) Column {
+	q := *p
+	q.Numeric = 0
+
+	var layouts []string
+	detected := map[string]int{}
+	yearless := map[string]bool{}
+	for _, v := range sample {
+		r, err := q.ParseResult(v)
+		// a bare one or two digit number is a quantity rather than a month
+		if err != nil || len(r.Layout) <= 2 {
+			continue
+		}
+		if detected[r.Layout] == 0 {
+			layouts = append(layouts, r.Layout)
+		}
+		detected[r.Layout]++
+		yearless[r.Layout] = r.YearInferred
+	}
+
+	best := Column{Total: len(sample), parser: q}
+	for _, layout := range layouts {
+		matched := 0
+		for _, v := range sample {
+			if _, err := time.Parse(layout, v); err == nil {
+				matched++
+			}
+		}
+		if matched > best.Matched || matched == best.Matched && detected[layout] > detected[best.Layout] {
+			best.Layout, best.Matched, best.yearless = layout, matched, yearless[layout]
+		}
+	}
+
+	return best
+}
+
+// inferNumeric picks the numeric encoding that converts the most values.
+func (p *Parser) inferNumeric(sample []string) Column {
+	best := Column{Total: len(sample), parser: *p}
+	for _, f := range numericFormats {
+		if p.Numeric != 0 && p.Numeric&f == 0 || p.Numeric == 0 && f != NumericExcel1900 {
+			continue
+		}
+		matched := 0
+		for _, v := range sample {
+			if _, ok := fromNumeric(v, f, time.UTC); !ok {
+				continue
+			}
+			if days, _, _ := splitDays(v); p.Numeric == 0 && (days < excelColumnMin || days > excelColumnMax) {
+				continue
+			}
+			matched++
+		}
+		if matched > best.Matched {
+			best.Numeric, best.Matched = f, matched
+		}
+	}
+
+	return best
+}
+
+// Parse parses a value of the column in the column's format.
+func (c Column) Parse(value string) (time.Time, error) {
+	value = strings.TrimSpace(Normalize(value).Text)
+	if c.Numeric != 0 {
+		r, ok := c.parser.parseNumeric(value, c.Numeric)
+		if !ok {
+			return time.Time{}, ErrInvalidDateFormat
+		}
+		return r.Time, nil
+	}
+
+	t, err := time.ParseInLocation(c.Layout, value, c.parser.location())
+	if err != nil {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	if c.yearless {
+		t = inferYear(t, c.parser.now(), c.parser.YearPolicy)
+	}
+	return t, nil
+}
@@ -0,0 +1,88 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInferColumn(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		numeric NumericFormat
+		layout  string
+		want    NumericFormat
+		parse   string
+		time    time.Time
+	}{
+		{
+			name:   "iso",
+			values: []string{"2024-11-24", "2024-11-25", "", "2024-12-01"},
+			layout: "2006-01-02",
+			parse:  "2024-02-29",
+			time:   time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "day first",
+			values: []string{"03/04/2024", "14/03/2024", "01/02/2024"},
+			layout: "02/01/2006",
+			parse:  "03/04/2024",
+			time:   time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "excel range",
+			values: []string{"45620", "45621.25", "45000"},
+			want:   NumericExcel1900,
+			parse:  "45620.5",
+			time:   time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "excel 1904",
+			values:  []string{"44158", "44159"},
+			numeric: NumericExcel1904,
+			want:    NumericExcel1904,
+			parse:   "44158",
+			time:    time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "compact",
+			values: []string{"20241124", "20241125"},
+			layout: "20060102",
+			parse:  "20241231",
+			time:   time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Numeric: tt.numeric}
+		got, err := p.InferColumn(tt.values)
+		if err != nil {
+			t.Errorf("%s: InferColumn() failed with %s", tt.name, err)
+			continue
+		}
+		if got.Layout != tt.layout || got.Numeric != tt.want {
+			t.Errorf("%s: InferColumn() = %q/%d, want %q/%d", tt.name, got.Layout, got.Numeric, tt.layout, tt.want)
+		}
+		v, err := got.Parse(tt.parse)
+		if err != nil || !v.Equal(tt.time) {
+			t.Errorf("%s: Column.Parse(\"%s\") = %v, %v, want %v", tt.name, tt.parse, v, err, tt.time)
+		}
+	}
+}
+
+func TestInferColumnErr(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"", " "},
+		{"12", "7", "300"},
+		{"12", "7", "11"},
+		{"45620", "1200", "99999"},
+		{"2024-11-24", "hello", "world"},
+	}
+
+	for _, values := range tests {
+		if got, err := InferColumn(values); err != ErrInvalidDateFormat {
+			t.Errorf("InferColumn(%q) = %+v, want ErrInvalidDateFormat", values, got)
+		}
+	}
+}
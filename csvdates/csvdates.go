@@ -0,0 +1,211 @@
+// Package csvdates reads CSV and TSV files, finding the date columns and
+// their formats from a sample of rows and parsing their values.
+package csvdates
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+// Options configures a Reader.
+type Options struct {
+	// SampleRows is the number of rows sampled to find the date columns.
+	// Defaults to 100.
+	SampleRows int
+	// Header says the first record holds column names.
+	Header bool
+	// Parser is used to infer and parse the columns, e.g. to enable numeric
+	// formats or set a location.
+	Parser goanydate.Parser
+	// NumericDates allows all-numeric columns to be read as dates, in
+	// Parser.Numeric or, when that is zero, as Excel serial numbers. It is
+	// off by default, since ZIP codes and IDs look the same.
+	NumericDates bool
+}
+
+// DateColumn is a column found to hold dates.
+type DateColumn struct {
+	Index int
+	// Name is the column name from the header, if any.
+	Name string
+	goanydate.Column
+}
+
+// Record is a CSV record with its date cells parsed.
+type Record struct {
+	Fields []string
+	// Times holds the parsed value of each non-empty date cell by column index.
+	Times map[int]time.Time
+	// Line is the line the record starts on.
+	Line int
+	// Errors lists the date cells that could not be parsed.
+	Errors []*CellError
+}
+
+// CellError reports a date cell that could not be parsed.
+type CellError struct {
+	Line, Column int
+	Value        string
+	Err          error
+}
+
+func (e *CellError) Error() string {
+	return fmt.Sprintf("csvdates: line %d, column %d: %q: %v", e.Line, e.Column+1, e.Value, e.Err)
+}
+
+func (e *CellError) Unwrap() error {
+	return e.Err
+}
+
+// RFC3339 returns the fields of the record with every parsed date cell
+// rewritten in RFC 3339. Cells that failed to parse are kept as they are.
+func (rec Record) RFC3339() []string {
+	fields := make([]string, len(rec.Fields))
+	copy(fields, rec.Fields)
+	for i, t := range rec.Times {
+		fields[i] = t.Format(time.RFC3339Nano)
+	}
+	return fields
+}
+
+type sampled struct {
+	fields []string
+	line   int
+	err    error
+}
+
+// Reader wraps a csv.Reader, whose Comma and other settings are kept, so a
+// TSV file is read by setting Comma to '\t'.
+type Reader struct {
+	r       *csv.Reader
+	opts    Options
+	header  []string
+	columns []DateColumn
+	sample  []sampled
+	err     error
+	done    bool
+}
+
+// NewReader returns a Reader reading from r.
+func NewReader(r *csv.Reader, opts Options) *Reader {
+	if opts.SampleRows <= 0 {
+		opts.SampleRows = 100
+	}
+	return &Reader{r: r, opts: opts}
+}
+
+// Header returns the column names, if Options.Header is set.
+func (r *Reader) Header() ([]string, error) {
+	if err := r.infer(); err != nil {
+		return nil, err
+	}
+	return r.header, nil
+}
+
+// Columns returns the date columns, sampling the input on the first call.
+func (r *Reader) Columns() ([]DateColumn, error) {
+	if err := r.infer(); err != nil {
+		return nil, err
+	}
+	return r.columns, nil
+}
+
+// Read returns the next record. Date cells that fail to parse are reported
+// in Record.Errors, and errors of the underlying csv.Reader, such as a
+// wrong number of fields, are returned with whatever record it read, so
+// reading can go on. Read returns io.EOF at the end of the input.
+func (r *Reader) Read() (Record, error) {
+	if err := r.infer(); err != nil {
+		return Record{}, err
+	}
+
+	var s sampled
+	if len(r.sample) > 0 {
+		s, r.sample = r.sample[0], r.sample[1:]
+	} else {
+		s = r.next()
+	}
+	if s.fields == nil {
+		return Record{}, s.err
+	}
+	return r.convert(s), s.err
+}
+
+func (r *Reader) next() sampled {
+	fields, err := r.r.Read()
+	s := sampled{fields: fields, err: err}
+	if len(fields) > 0 {
+		s.line, _ = r.r.FieldPos(0)
+	}
+	return s
+}
+
+// infer reads the header and the sample rows and finds the date columns.
+func (r *Reader) infer() error {
+	if r.done {
+		return r.err
+	}
+	r.done = true
+
+	if r.opts.Header {
+		s := r.next()
+		if s.err != nil && s.fields == nil {
+			if errors.Is(s.err, io.EOF) {
+				return nil
+			}
+			r.err = s.err
+			return r.err
+		}
+		r.header = s.fields
+	}
+
+	var values [][]string
+	for len(r.sample) < r.opts.SampleRows {
+		s := r.next()
+		if s.fields == nil && errors.Is(s.err, io.EOF) {
+			break
+		}
+		r.sample = append(r.sample, s)
+		for i, v := range s.fields {
+			for len(values) <= i {
+				values = append(values, nil)
+			}
+			values[i] = append(values[i], v)
+		}
+	}
+
+	for i, v := range values {
+		c, err := r.opts.Parser.InferColumn(v)
+		if err != nil || c.Numeric != 0 && !r.opts.NumericDates {
+			continue
+		}
+		dc := DateColumn{Index: i, Column: c}
+		if i < len(r.header) {
+			dc.Name = r.header[i]
+		}
+		r.columns = append(r.columns, dc)
+	}
+	return nil
+}
+
+func (r *Reader) convert(s sampled) Record {
+	rec := Record{Fields: s.fields, Times: map[int]time.Time{}, Line: s.line}
+	for _, c := range r.columns {
+		if c.Index >= len(s.fields) || strings.TrimSpace(s.fields[c.Index]) == "" {
+			continue
+		}
+		t, err := c.Parse(s.fields[c.Index])
+		if err != nil {
+			rec.Errors = append(rec.Errors, &CellError{Line: s.line, Column: c.Index, Value: s.fields[c.Index], Err: err})
+			continue
+		}
+		rec.Times[c.Index] = t
+	}
+	return rec
+}
@@ -0,0 +1,131 @@
+package csvdates
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+func TestReader(t *testing.T) {
+	in := `id,created,name,shipped
+1,14/03/2024,apple,2024-03-15T10:00:00Z
+2,03/04/2024,pear,
+3,05/04/2024,plum,2024-04-06T09:30:00Z
+4,not a date,fig,2024-04-07T08:00:00Z
+`
+	r := NewReader(csv.NewReader(strings.NewReader(in)), Options{Header: true, SampleRows: 3})
+
+	columns, err := r.Columns()
+	if err != nil {
+		t.Fatalf("Columns failed with %s", err)
+	}
+	if len(columns) != 2 || columns[0].Name != "created" || columns[0].Layout != "02/01/2006" || columns[1].Name != "shipped" {
+		t.Fatalf("Columns = %+v, want created and shipped", columns)
+	}
+
+	want := []struct {
+		times  map[int]time.Time
+		errors int
+		line   int
+	}{
+		{times: map[int]time.Time{1: time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC), 3: time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC)}, line: 2},
+		{times: map[int]time.Time{1: time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC)}, line: 3},
+		{times: map[int]time.Time{1: time.Date(2024, time.April, 5, 0, 0, 0, 0, time.UTC), 3: time.Date(2024, time.April, 6, 9, 30, 0, 0, time.UTC)}, line: 4},
+		{times: map[int]time.Time{3: time.Date(2024, time.April, 7, 8, 0, 0, 0, time.UTC)}, errors: 1, line: 5},
+	}
+	for i, w := range want {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatalf("Read %d failed with %s", i, err)
+		}
+		if len(rec.Times) != len(w.times) || len(rec.Errors) != w.errors || rec.Line != w.line {
+			t.Errorf("Read %d = %+v, want %+v", i, rec, w)
+			continue
+		}
+		for c, tm := range w.times {
+			if !rec.Times[c].Equal(tm) {
+				t.Errorf("Read %d column %d = %v, want %v", i, c, rec.Times[c], tm)
+			}
+		}
+	}
+	if _, err := r.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Read at end = %v, want io.EOF", err)
+	}
+}
+
+func TestReaderRFC3339(t *testing.T) {
+	in := "when\tcount\nNov 24, 2024\t3\nNov 25, 2024\t4\n"
+	cr := csv.NewReader(strings.NewReader(in))
+	cr.Comma = '\t'
+	loc, _ := time.LoadLocation("Europe/Berlin")
+	r := NewReader(cr, Options{Header: true, Parser: goanydate.Parser{Location: loc}})
+
+	want := [][]string{
+		{"2024-11-24T00:00:00+01:00", "3"},
+		{"2024-11-25T00:00:00+01:00", "4"},
+	}
+	for i, w := range want {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatalf("Read %d failed with %s", i, err)
+		}
+		if got := rec.RFC3339(); strings.Join(got, ",") != strings.Join(w, ",") {
+			t.Errorf("RFC3339 %d = %v, want %v", i, got, w)
+		}
+		if rec.Fields[0] == w[0] {
+			t.Errorf("RFC3339 %d changed the record fields", i)
+		}
+	}
+}
+
+func TestReaderNumeric(t *testing.T) {
+	in := "zip,serial\n45620,45620\n30301,45621\n"
+	r := NewReader(csv.NewReader(strings.NewReader(in)), Options{Header: true})
+	rec, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read failed with %s", err)
+	}
+	if len(rec.Times) != 0 || rec.Fields[0] != "45620" || rec.RFC3339()[0] != "45620" {
+		t.Errorf("Read = %+v, want the ZIP column unchanged", rec)
+	}
+
+	r = NewReader(csv.NewReader(strings.NewReader(in)), Options{Header: true, NumericDates: true})
+	columns, err := r.Columns()
+	if err != nil {
+		t.Fatalf("Columns failed with %s", err)
+	}
+	if len(columns) != 2 || columns[1].Numeric != goanydate.NumericExcel1900 {
+		t.Fatalf("Columns = %+v, want Excel serial numbers", columns)
+	}
+	rec, _ = r.Read()
+	if want := time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC); !rec.Times[1].Equal(want) {
+		t.Errorf("Read column 1 = %v, want %v", rec.Times[1], want)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	in := "2024-11-24,a\n2024-11-25\n2024-11-26,c\n"
+	r := NewReader(csv.NewReader(strings.NewReader(in)), Options{SampleRows: 1})
+
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("Read 0 failed with %s", err)
+	}
+	rec, err := r.Read()
+	if !errors.Is(err, csv.ErrFieldCount) || len(rec.Times) != 1 {
+		t.Errorf("Read 1 = %+v, %v, want record and csv.ErrFieldCount", rec, err)
+	}
+	rec, err = r.Read()
+	if err != nil || len(rec.Times) != 1 {
+		t.Errorf("Read 2 = %+v, %v, want the record", rec, err)
+	}
+
+	empty := NewReader(csv.NewReader(strings.NewReader("")), Options{Header: true})
+	if _, err := empty.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Read of empty input = %v, want io.EOF", err)
+	}
+}
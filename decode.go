@@ -0,0 +1,185 @@
+package goanydate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FieldError reports a struct field that Decode could not fill.
+type FieldError struct {
+	// Field is the name of the struct field, Key the source key it reads.
+	Field string
+	Key   string
+	Value string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("goanydate: field %s (%s): %q: %v", e.Field, e.Key, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError lists every field Decode could not fill.
+type DecodeError struct {
+	Fields []*FieldError
+}
+
+func (e *DecodeError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *DecodeError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	anyTimeType = reflect.TypeOf(Time{})
+)
+
+// Decode fills the time.Time, *time.Time, Time and *Time fields of the struct
+// dst points to from src, with the zero Parser.
+func Decode(src map[string]string, dst any) error {
+	p := Parser{}
+	return p.Decode(src, dst)
+}
+
+// Decode fills the time.Time, *time.Time, Time and *Time fields of the struct
+// dst points to from src. A field reads the key named by its anydate tag, or
+// its own name, and the tag can set the date order and location:
+//
+//	Created time.Time `anydate:"created,order=dmy,loc=Europe/Berlin"`
+//
+// Fields whose key is missing or empty are left alone, and a tag of "-"
+// skips the field. Fields that fail to parse are reported together in a
+// *DecodeError while the others are still filled. Non-nil pointers are
+// decoded into, and a Time keeps its MarshalLayout.
+func (p *Parser) Decode(src map[string]string, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("goanydate: Decode needs a non-nil pointer to a struct")
+	}
+
+	var errs []*FieldError
+	p.decodeStruct(src, v.Elem(), &errs)
+	if len(errs) > 0 {
+		return &DecodeError{Fields: errs}
+	}
+	return nil
+}
+
+func (p *Parser) decodeStruct(src map[string]string, v reflect.Value, errs *[]*FieldError) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, tagged := f.Tag.Lookup("anydate")
+		if tag == "-" || !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft != timeType && ft != anyTimeType || !f.IsExported() {
+			if f.Anonymous && ft.Kind() == reflect.Struct && f.Type.Kind() == reflect.Struct {
+				p.decodeStruct(src, v.Field(i), errs)
+			} else if tagged {
+				*errs = append(*errs, &FieldError{Field: f.Name, Err: fmt.Errorf("unsupported type %s", f.Type)})
+			}
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		value, ok := lookupKey(src, name)
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		fe := &FieldError{Field: f.Name, Key: name, Value: value}
+		q, err := p.withOptions(opts)
+		if err != nil {
+			fe.Err = err
+			*errs = append(*errs, fe)
+			continue
+		}
+		r, err := q.ParseResult(value)
+		if err != nil {
+			fe.Err = err
+			*errs = append(*errs, fe)
+			continue
+		}
+
+		// a non-nil pointer is decoded into, like encoding/json does
+		field := v.Field(i)
+		if f.Type.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				field.Set(reflect.New(ft))
+			}
+			field = field.Elem()
+		}
+		fv := reflect.ValueOf(r.Time)
+		if ft == anyTimeType {
+			old := field.Interface().(Time)
+			fv = reflect.ValueOf(Time{Time: r.Time, Layout: r.Layout, YearInferred: r.YearInferred, MarshalLayout: old.MarshalLayout})
+		}
+		field.Set(fv)
+	}
+}
+
+// lookupKey finds name in src, preferring an exact match to a case-insensitive one.
+func lookupKey(src map[string]string, name string) (string, bool) {
+	if v, ok := src[name]; ok {
+		return v, true
+	}
+	for k, v := range src {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// withOptions returns a copy of p with the order and loc options of a tag applied.
+func (p *Parser) withOptions(opts string) (*Parser, error) {
+	q := *p
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(opt, "=")
+		switch key {
+		case "order":
+			order, ok := ParseDateOrder(value)
+			if !ok {
+				return nil, fmt.Errorf("unknown date order %q", value)
+			}
+			q.Order = order
+		case "loc":
+			loc, err := time.LoadLocation(value)
+			if err != nil {
+				return nil, err
+			}
+			q.Location = loc
+		default:
+			return nil, fmt.Errorf("unknown tag option %q", key)
+		}
+	}
+	return &q, nil
+}
@@ -0,0 +1,120 @@
+package goanydate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type decodeEmbedded struct {
+	Updated time.Time `anydate:"updated"`
+}
+
+type decodeTarget struct {
+	decodeEmbedded
+	Created  time.Time  `anydate:"created,order=dmy"`
+	Deadline *time.Time `anydate:"deadline,loc=Europe/Berlin"`
+	Seen     Time       `anydate:"seen"`
+	Shipped  *Time
+	Skipped  time.Time `anydate:"-"`
+	Name     string
+	internal time.Time
+}
+
+func TestDecode(t *testing.T) {
+	src := map[string]string{
+		"created":  "03/04/2024",
+		"deadline": "2024-11-24 18:00",
+		"seen":     "Nov 24, 2024",
+		"SHIPPED":  "2024-11-25T08:00:00Z",
+		"updated":  "2024-11-26",
+		"Skipped":  "2024-11-27",
+		"internal": "2024-11-28",
+	}
+
+	var got decodeTarget
+	if err := Decode(src, &got); err != nil {
+		t.Fatalf("Decode failed with %s", err)
+	}
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	if want := time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC); !got.Created.Equal(want) {
+		t.Errorf("Created = %v, want %v", got.Created, want)
+	}
+	if want := time.Date(2024, time.November, 24, 18, 0, 0, 0, berlin); got.Deadline == nil || !got.Deadline.Equal(want) {
+		t.Errorf("Deadline = %v, want %v", got.Deadline, want)
+	}
+	if want := time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC); !got.Seen.Equal(want) || got.Seen.Layout != "Jan 02, 2006" {
+		t.Errorf("Seen = %v, %s, want %v, Jan 02, 2006", got.Seen.Time, got.Seen.Layout, want)
+	}
+	if want := time.Date(2024, time.November, 25, 8, 0, 0, 0, time.UTC); got.Shipped == nil || !got.Shipped.Equal(want) {
+		t.Errorf("Shipped = %v, want %v", got.Shipped, want)
+	}
+	if want := time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC); !got.Updated.Equal(want) {
+		t.Errorf("Updated = %v, want %v", got.Updated, want)
+	}
+	if !got.Skipped.IsZero() || !got.internal.IsZero() {
+		t.Errorf("Skipped = %v, internal = %v, want zero", got.Skipped, got.internal)
+	}
+}
+
+func TestDecodeMarshalLayout(t *testing.T) {
+	src := map[string]string{"seen": "Nov 24, 2024", "SHIPPED": "Nov 25, 2024"}
+	shipped := &Time{MarshalLayout: time.DateOnly}
+	got := decodeTarget{Seen: Time{MarshalLayout: time.DateOnly}, Shipped: shipped}
+	if err := Decode(src, &got); err != nil {
+		t.Fatalf("Decode failed with %s", err)
+	}
+
+	if got.Seen.String() != "2024-11-24" {
+		t.Errorf("Seen = %s, want 2024-11-24", got.Seen)
+	}
+	if got.Shipped != shipped || got.Shipped.String() != "2024-11-25" {
+		t.Errorf("Shipped = %p %s, want %p 2024-11-25", got.Shipped, got.Shipped, shipped)
+	}
+}
+
+func TestDecodeErr(t *testing.T) {
+	src := map[string]string{
+		"created":  "not a date",
+		"deadline": "",
+		"seen":     "2024-11-24",
+	}
+	var got struct {
+		Created  time.Time  `anydate:"created"`
+		Deadline *time.Time `anydate:"deadline"`
+		Seen     time.Time  `anydate:"seen,loc=Nowhere/Special"`
+		Count    int        `anydate:"count"`
+		Other    time.Time  `anydate:"other,order=ydm"`
+	}
+	got.Other = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	src["other"] = "2024-01-02"
+
+	err := Decode(src, &got)
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("Decode error = %v, want *DecodeError", err)
+	}
+	fields := map[string]bool{}
+	for _, f := range de.Fields {
+		fields[f.Field] = true
+	}
+	for _, f := range []string{"Created", "Seen", "Count", "Other"} {
+		if !fields[f] {
+			t.Errorf("Decode errors %v, missing %s", err, f)
+		}
+	}
+	if len(de.Fields) != 4 {
+		t.Errorf("Decode errors %v, want 4", err)
+	}
+	if !errors.Is(err, ErrInvalidDateFormat) {
+		t.Errorf("Decode error %v does not wrap ErrInvalidDateFormat", err)
+	}
+	if got.Deadline != nil {
+		t.Errorf("Deadline = %v, want nil", got.Deadline)
+	}
+
+	if err := Decode(src, got); err == nil {
+		t.Errorf("Decode into a struct value succeeded")
+	}
+}
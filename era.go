@@ -0,0 +1,146 @@
+package goanydate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// japaneseEra is an imperial era of the Japanese calendar.
+type japaneseEra struct {
+	kanji   string
+	initial byte
+	start   time.Time
+}
+
+// japaneseEras lists the modern eras in order; each ends the day before the
+// next begins.
+var japaneseEras = []japaneseEra{
+	{kanji: "明治", initial: 'M', start: time.Date(1868, time.October, 23, 0, 0, 0, 0, time.UTC)},
+	{kanji: "大正", initial: 'T', start: time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC)},
+	{kanji: "昭和", initial: 'S', start: time.Date(1926, time.December, 25, 0, 0, 0, 0, time.UTC)},
+	{kanji: "平成", initial: 'H', start: time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC)},
+	{kanji: "令和", initial: 'R', start: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+// eraDate is a Japanese era date such as "令和6年11月24日" or "R6.11.24".
+type eraDate struct {
+	date time.Time
+	// rest is whatever follows the date, e.g. " 15:04".
+	rest string
+}
+
+// parseEraDate splits input into a Japanese era date and the remainder. It
+// reports whether input starts like an era date, and an error when that date
+// is invalid or outside its era, so "H31.5.1" is rejected as Reiwa had begun.
+func parseEraDate(input string) (eraDate, bool, error) {
+	var e eraDate
+	era := -1
+	kanji := false
+	for i, j := range japaneseEras {
+		if strings.HasPrefix(input, j.kanji) {
+			era, kanji = i, true
+			input = input[len(j.kanji):]
+			break
+		}
+		if len(input) > 2 && (input[0] == j.initial || input[0] == j.initial+'a'-'A') && isASCIIDigits(input[1:2]) {
+			era = i
+			input = input[1:]
+			break
+		}
+	}
+	if era < 0 {
+		return e, false, nil
+	}
+	if !kanji {
+		// "R6.11.24" but not "T12:00"
+		n := strings.IndexAny(input, "./-")
+		if n < 1 || n > 2 || !isASCIIDigits(input[:n]) {
+			return e, false, nil
+		}
+	}
+
+	var fields [3]int
+	if kanji {
+		// 元年 is the first year of an era
+		input = strings.TrimLeft(input, " ")
+		if strings.HasPrefix(input, "元") {
+			input = "1" + input[len("元"):]
+		}
+		for i, unit := range []string{"年", "月", "日"} {
+			var ok bool
+			if fields[i], input, ok = eraNumber(strings.TrimLeft(input, " "), 4); !ok {
+				return e, true, ErrInvalidDateFormat
+			}
+			input = strings.TrimLeft(input, " ")
+			if !strings.HasPrefix(input, unit) {
+				return e, true, ErrInvalidDateFormat
+			}
+			input = input[len(unit):]
+		}
+	} else {
+		var sep byte
+		for i := range fields {
+			var ok bool
+			if fields[i], input, ok = eraNumber(input, 2); !ok {
+				return e, true, ErrInvalidDateFormat
+			}
+			if i == 2 {
+				break
+			}
+			if input == "" || sep != 0 && input[0] != sep || !strings.ContainsRune("./-", rune(input[0])) {
+				return e, true, ErrInvalidDateFormat
+			}
+			sep = input[0]
+			input = input[1:]
+		}
+	}
+	if input != "" && input[0] != ' ' && input[0] != 'T' {
+		return e, true, ErrInvalidDateFormat
+	}
+	e.rest = input
+
+	year, month, day := fields[0], fields[1], fields[2]
+	start := japaneseEras[era].start
+	e.date = time.Date(start.Year()+year-1, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if year < 1 || month < 1 || month > 12 || e.date.Day() != day || e.date.Before(start) {
+		return e, true, ErrInvalidDateFormat
+	}
+	if era+1 < len(japaneseEras) && !e.date.Before(japaneseEras[era+1].start) {
+		return e, true, ErrInvalidDateFormat
+	}
+	return e, true, nil
+}
+
+// eraNumber reads up to max leading ASCII digits of s.
+func eraNumber(s string, max int) (int, string, bool) {
+	n := 0
+	for n < len(s) && n < max && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	if n == 0 || n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		return 0, s, false
+	}
+	v, _ := strconv.Atoi(s[:n])
+	return v, s[n:], true
+}
+
+// parseEraDate parses a Japanese era date by rewriting it as the Gregorian
+// date it stands for, like week dates. Era dates have no Go layout.
+func (p *Parser) parseEraDate(input string) (Result, bool, error) {
+	e, ok, err := parseEraDate(input)
+	if !ok {
+		return Result{}, false, nil
+	}
+	if err != nil {
+		return Result{}, true, err
+	}
+
+	r, err := p.ParseResult(e.date.Format("2006-01-02") + e.rest)
+	if err != nil {
+		return Result{}, true, err
+	}
+	r.Layout = ""
+
+	return r, true, nil
+}
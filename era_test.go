@@ -0,0 +1,63 @@
+package goanydate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEraDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "令和6年11月24日", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "R6.11.24", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "r6/11/24", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "R06-11-24 15:04", want: time.Date(2024, time.November, 24, 15, 4, 0, 0, time.UTC)},
+		{in: "令和元年5月1日", want: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "令和 6 年 11 月 24 日", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "平成31年4月30日", want: time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{in: "H1.1.8", want: time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC)},
+		{in: "S64.1.7", want: time.Date(1989, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{in: "昭和20年8月15日", want: time.Date(1945, time.August, 15, 0, 0, 0, 0, time.UTC)},
+		{in: "T1.7.30", want: time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC)},
+		{in: "明治45年7月29日", want: time.Date(1912, time.July, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+		if _, err := DetectFormat(tt.in); !errors.Is(err, ErrNoLayout) {
+			t.Errorf("DetectFormat(\"%s\") error = %v, want ErrNoLayout", tt.in, err)
+		}
+	}
+}
+
+func TestParseEraDateErr(t *testing.T) {
+	tests := []string{
+		"H31.5.1",
+		"平成32年1月1日",
+		"R1.4.30",
+		"S64.1.8",
+		"M1.1.1",
+		"R0.11.24",
+		"R6.13.24",
+		"R6.2.30",
+		"R6.11/24",
+		"令和6年11月",
+		"R6.11.24x",
+	}
+
+	for _, in := range tests {
+		if got, err := Parse(in); err == nil {
+			t.Errorf("Parse(\"%s\") = %v, want error", in, got)
+		}
+	}
+}
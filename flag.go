@@ -0,0 +1,54 @@
+package goanydate
+
+import (
+	"time"
+)
+
+// TimeFlag is a flag.Value, also usable with pflag, holding a time given in
+// any format Parse accepts. With Relative set it also takes the expressions
+// of ParseRelative, such as "yesterday" or "2h ago", relative to Parser.Now.
+//
+//	since := goanydate.TimeFlag{Relative: true}
+//	flag.Var(&since, "since", "show entries newer than this")
+type TimeFlag struct {
+	Time time.Time
+	// Parser parses the flag value and supplies the reference clock.
+	Parser Parser
+	// Relative enables relative expressions.
+	Relative bool
+	// Layout formats String. Defaults to RFC 3339.
+	Layout string
+}
+
+// Set implements flag.Value.
+func (f *TimeFlag) Set(s string) error {
+	if f.Relative {
+		if t, err := f.Parser.ParseRelative(s); err == nil {
+			f.Time = t
+			return nil
+		}
+	}
+	t, err := f.Parser.Parse(s)
+	if err != nil {
+		return err
+	}
+	f.Time = t
+	return nil
+}
+
+// String implements flag.Value. An unset flag is the empty string.
+func (f *TimeFlag) String() string {
+	if f == nil || f.Time.IsZero() {
+		return ""
+	}
+	layout := f.Layout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return f.Time.Format(layout)
+}
+
+// Type implements pflag.Value.
+func (f *TimeFlag) Type() string {
+	return "time"
+}
@@ -0,0 +1,52 @@
+package goanydate
+
+import (
+	"flag"
+	"testing"
+	"time"
+)
+
+func TestTimeFlag(t *testing.T) {
+	now := time.Date(2024, time.November, 24, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in       string
+		relative bool
+		layout   string
+		want     string
+	}{
+		{in: "2024-11-20 10:00", want: "2024-11-20T10:00:00Z"},
+		{in: "Nov 20, 2024", layout: time.DateOnly, want: "2024-11-20"},
+		{in: "yesterday", relative: true, want: "2024-11-23T00:00:00Z"},
+		{in: "2h ago", relative: true, layout: time.Kitchen, want: "1:04PM"},
+		{in: "2024-11-20", relative: true, want: "2024-11-20T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		f := TimeFlag{Parser: Parser{Now: func() time.Time { return now }}, Relative: tt.relative, Layout: tt.layout}
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&f, "since", "")
+		if err := fs.Parse([]string{"-since", tt.in}); err != nil {
+			t.Errorf("Set(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if got := f.String(); got != tt.want {
+			t.Errorf("Set(\"%s\"); String() = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTimeFlagErr(t *testing.T) {
+	var f TimeFlag
+	if err := f.Set("yesterday"); err == nil {
+		t.Errorf("Set(\"yesterday\") without Relative = %v, want error", f.Time)
+	}
+	if err := f.Set("not a date"); err == nil {
+		t.Errorf("Set(\"not a date\") = %v, want error", f.Time)
+	}
+	if got := f.String(); got != "" {
+		t.Errorf("String() of unset flag = %s, want empty", got)
+	}
+	if got := f.Type(); got != "time" {
+		t.Errorf("Type() = %s, want time", got)
+	}
+}
@@ -0,0 +1,74 @@
+package goanydate
+
+import (
+	"time"
+)
+
+// Layouts of the three HTTP-date formats allowed by RFC 9110, section 5.6.7.
+const (
+	// IMFFixdate is the preferred format, e.g. "Sun, 06 Nov 1994 08:49:37 GMT".
+	IMFFixdate = "Mon, 02 Jan 2006 15:04:05 GMT"
+	// RFC850Date is the obsolete RFC 850 format, e.g. "Sunday, 06-Nov-94 08:49:37 GMT".
+	RFC850Date = "Monday, 02-Jan-06 15:04:05 GMT"
+	// ANSICDate is the ANSI C asctime() format, e.g. "Sun Nov  6 08:49:37 1994".
+	ANSICDate = "Mon Jan _2 15:04:05 2006"
+)
+
+var httpDateLayouts = []string{
+	IMFFixdate,
+	RFC850Date,
+	ANSICDate,
+}
+
+// detectHTTPDate reports the HTTP-date layout matching input exactly.
+func detectHTTPDate(input string) (string, bool) {
+	for _, layout := range httpDateLayouts {
+		// asctime only differs from the generic layout when the day is space padded
+		if layout == ANSICDate && (len(input) < 9 || input[8] != ' ') {
+			continue
+		}
+		t, err := time.Parse(layout, input)
+		if err != nil {
+			continue
+		}
+		if t.Format(layout) == input {
+			return layout, true
+		}
+	}
+
+	return "", false
+}
+
+// ParseHTTPDate parses an HTTP-date in any of the formats allowed by RFC 9110:
+// IMF-fixdate, RFC 850 and ANSI C asctime. The time zone must be GMT, the
+// weekday must match the date, and the result is always in UTC.
+//
+// A two-digit RFC 850 year is taken in the current century unless that puts
+// the date more than 50 years in the future, in which case it is the most
+// recent past year with the same last two digits.
+func ParseHTTPDate(s string) (time.Time, error) {
+	return parseHTTPDate(s, time.Now())
+}
+
+func parseHTTPDate(s string, now time.Time) (time.Time, error) {
+	for _, layout := range httpDateLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if layout == RFC850Date {
+			year := now.Year() - now.Year()%100 + t.Year()%100
+			t = t.AddDate(year-t.Year(), 0, 0)
+			if t.After(now.AddDate(50, 0, 0)) {
+				t = t.AddDate(-100, 0, 0)
+			}
+		}
+		// time.Parse ignores the weekday
+		if t.Format(layout) != s {
+			return time.Time{}, ErrInvalidDateFormat
+		}
+		return t.UTC(), nil
+	}
+
+	return time.Time{}, ErrInvalidDateFormat
+}
@@ -0,0 +1,93 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectHTTPDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Sun, 06 Nov 1994 08:49:37 GMT", want: IMFFixdate},
+		{in: "Sunday, 06-Nov-94 08:49:37 GMT", want: RFC850Date},
+		{in: "Sun Nov  6 08:49:37 1994", want: ANSICDate},
+		{in: "Thu, 28 Nov 2024 11:37:05 GMT", want: IMFFixdate},
+		{in: "Sun Nov 16 08:49:37 1994", want: "Mon Jan 02 15:04:05 2006"},
+		{in: "Mon, 06 Nov 1994 08:49:37 GMT", want: "Mon, 02 Jan 2006 15:04:05 MST"},
+	}
+
+	for _, tt := range tests {
+		got, err := DetectFormat(tt.in)
+		if err != nil {
+			t.Errorf("DetectFormat(\"%s\") failed with %s", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("DetectFormat(\"%s\") = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseHTTPDate(t *testing.T) {
+	want := time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)
+	tests := []string{
+		"Sun, 06 Nov 1994 08:49:37 GMT",
+		"Sunday, 06-Nov-94 08:49:37 GMT",
+		"Sun Nov  6 08:49:37 1994",
+	}
+
+	for _, in := range tests {
+		got, err := ParseHTTPDate(in)
+		if err != nil {
+			t.Errorf("ParseHTTPDate(\"%s\") failed with %s", in, err)
+		}
+		if !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("ParseHTTPDate(\"%s\") = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestParseHTTPDateErr(t *testing.T) {
+	tests := []string{
+		"Sun, 06 Nov 1994 08:49:37 PST",
+		"Sunday, 06-Nov-94 08:49:37 +0000",
+		"1994-11-06T08:49:37Z",
+		"Mon, 06 Nov 1994 08:49:37 GMT",
+		"Monday, 06-Nov-94 08:49:37 GMT",
+		"Mon Nov  6 08:49:37 1994",
+		"",
+	}
+
+	for _, in := range tests {
+		got, err := ParseHTTPDate(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("ParseHTTPDate(\"%s\") = %v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
+
+func TestParseHTTPDateCentury(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "Sunday, 06-Nov-94 08:49:37 GMT", want: time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)},
+		{in: "Wednesday, 06-Nov-69 08:49:37 GMT", want: time.Date(2069, time.November, 6, 8, 49, 37, 0, time.UTC)},
+		{in: "Thursday, 06-Nov-25 08:49:37 GMT", want: time.Date(2025, time.November, 6, 8, 49, 37, 0, time.UTC)},
+		{in: "Sunday, 18-Oct-76 00:00:00 GMT", want: time.Date(2076, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{in: "Wednesday, 20-Oct-76 00:00:00 GMT", want: time.Date(1976, time.October, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := parseHTTPDate(tt.in, now)
+		if err != nil {
+			t.Errorf("ParseHTTPDate(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseHTTPDate(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
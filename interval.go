@@ -0,0 +1,287 @@
+package goanydate
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDuration is returned for malformed ISO 8601 durations.
+var ErrInvalidDuration = errors.New("invalid ISO 8601 duration")
+
+// Period is an ISO 8601 duration such as "P1Y2M10DT2H30M". The calendar part
+// is kept apart from the clock part because years, months and days have no
+// fixed length.
+type Period struct {
+	Years, Months, Weeks, Days int
+	Clock                      time.Duration
+}
+
+// AddTo returns t moved forward by the period. Years and months are added
+// first and keep the day within the month, so 2024-01-31 plus P1M is
+// 2024-02-29 rather than March 2 as with time.Time.AddDate.
+func (d Period) AddTo(t time.Time) time.Time {
+	return addMonths(t, d.Years*12+d.Months).AddDate(0, 0, d.Weeks*7+d.Days).Add(d.Clock)
+}
+
+// SubFrom returns t moved back by the period, clamping days like AddTo.
+func (d Period) SubFrom(t time.Time) time.Time {
+	return addMonths(t.Add(-d.Clock).AddDate(0, 0, -(d.Weeks*7+d.Days)), -(d.Years*12 + d.Months))
+}
+
+// addMonths adds n months to t, moving a day past the end of the target
+// month back to its last day.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, day := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// Interval is an ISO 8601 time interval.
+type Interval struct {
+	Start, End time.Time
+	Duration   Period
+}
+
+// ParseDuration8601 parses an ISO 8601 duration such as "P3DT4H" or "PT0.5S".
+// Only the hour, minute and second components may carry a fraction.
+func ParseDuration8601(s string) (Period, error) {
+	var d Period
+	s = Normalize(s).Text
+	if len(s) < 3 || s[0] != 'P' {
+		return d, ErrInvalidDuration
+	}
+
+	units := "YMWD"
+	last := -1
+	inTime, seen := false, false
+	num := ""
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9' || c == '.' || c == ',':
+			num += string(c)
+		case c == 'T':
+			if inTime || num != "" {
+				return Period{}, ErrInvalidDuration
+			}
+			inTime, seen = true, false
+			units, last = "HMS", -1
+		default:
+			u := strings.IndexByte(units, c)
+			if num == "" || u <= last {
+				return Period{}, ErrInvalidDuration
+			}
+			last, seen = u, true
+			if err := d.set(c, inTime, num); err != nil {
+				return Period{}, err
+			}
+			num = ""
+		}
+	}
+	if num != "" || !seen {
+		return Period{}, ErrInvalidDuration
+	}
+
+	return d, nil
+}
+
+func (d *Period) set(unit byte, inTime bool, num string) error {
+	if inTime {
+		v, err := strconv.ParseFloat(strings.Replace(num, ",", ".", 1), 64)
+		if err != nil {
+			return ErrInvalidDuration
+		}
+		scale := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}[unit]
+		d.Clock += time.Duration(v * float64(scale))
+		return nil
+	}
+
+	v, err := strconv.Atoi(num)
+	if err != nil {
+		return ErrInvalidDuration
+	}
+	switch unit {
+	case 'Y':
+		d.Years = v
+	case 'M':
+		d.Months = v
+	case 'W':
+		d.Weeks = v
+	case 'D':
+		d.Days = v
+	}
+	return nil
+}
+
+// ParseInterval parses an ISO 8601 time interval with the zero Parser.
+func ParseInterval(s string) (Interval, error) {
+	p := Parser{}
+	return p.ParseInterval(s)
+}
+
+// ParseInterval parses an ISO 8601 time interval in any of its forms:
+//
+//	2024-01-01T00:00Z/2024-02-01T00:00Z
+//	2024-01-01/P1M
+//	P1M/2024-02-01
+//	2024-02-15/18
+//
+// Each endpoint goes through the detector, so any format Parse accepts can be
+// used. An end that omits leading fields takes them, and the time zone, from
+// the start. The returned Duration is calendar-aware: a month-long interval
+// reports Months: 1 regardless of the month's length.
+func (p *Parser) ParseInterval(s string) (Interval, error) {
+	s = Normalize(s).Text
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		start, end, ok = strings.Cut(s, "--")
+	}
+	if !ok || start == "" || end == "" {
+		return Interval{}, ErrInvalidDateFormat
+	}
+
+	var iv Interval
+	var err error
+	switch {
+	case start[0] == 'P' && end[0] == 'P':
+		return Interval{}, ErrInvalidDateFormat
+	case end[0] == 'P':
+		if iv.Start, err = p.Parse(start); err != nil {
+			return Interval{}, err
+		}
+		if iv.Duration, err = ParseDuration8601(end); err != nil {
+			return Interval{}, err
+		}
+		iv.End = iv.Duration.AddTo(iv.Start)
+	case start[0] == 'P':
+		if iv.End, err = p.Parse(end); err != nil {
+			return Interval{}, err
+		}
+		if iv.Duration, err = ParseDuration8601(start); err != nil {
+			return Interval{}, err
+		}
+		iv.Start = iv.Duration.SubFrom(iv.End)
+	default:
+		if iv.Start, err = p.Parse(start); err != nil {
+			return Interval{}, err
+		}
+		if iv.End, err = p.Parse(p.completeEnd(start, end)); err != nil {
+			return Interval{}, err
+		}
+		if iv.End.Before(iv.Start) {
+			return Interval{}, ErrInvalidDateFormat
+		}
+		iv.Duration = periodBetween(iv.Start, iv.End)
+	}
+
+	return iv, nil
+}
+
+// completeEnd fills the leading fields an interval end leaves out, e.g. the
+// end "18" of "2024-02-15/18" becomes "2024-02-18". The date and the time are
+// completed separately: an end with only a time keeps the start's date, and
+// an end with only a date keeps the start's time.
+func (p *Parser) completeEnd(start, end string) string {
+	if _, ok := parseWeekDate(end); ok {
+		return end
+	}
+	d := adDetector{}
+	components, err := d.extractComponents(end)
+	if err != nil || hasComponent(components, ctYear) {
+		return end
+	}
+
+	// the start's time zone applies to an end without one
+	start, zone := splitZone(start)
+	end, endZone := splitZone(end)
+	if endZone != "" {
+		zone = endZone
+	}
+
+	startDate, startSep, startClock := splitClock(start)
+	date, sep, clock := splitClock(end)
+	if date == "" {
+		date = startDate
+	} else {
+		date = completeDate(startDate, date)
+	}
+	if clock == "" {
+		sep, clock = startSep, startClock
+	}
+	if clock == "" || date == "" {
+		return date + clock + zone
+	}
+	if sep == "" {
+		sep = startSep
+	}
+	if sep == "" {
+		sep = "T"
+	}
+
+	return date + sep + clock + zone
+}
+
+// splitClock splits a timestamp into its date, the separator in front of the
+// time and the time. A value with a colon but no separator is a bare time.
+func splitClock(s string) (string, string, string) {
+	if i := strings.IndexAny(s, "T "); i >= 0 {
+		return s[:i], s[i : i+1], s[i+1:]
+	}
+	if strings.Contains(s, ":") {
+		return "", "", s
+	}
+	return s, "", ""
+}
+
+// completeDate replaces the trailing fields of the start date with those of
+// the end, e.g. "03-14" on "2008-02-15" gives "2008-03-14". Dates without
+// separators are completed digit by digit.
+func completeDate(start, end string) string {
+	if !strings.Contains(start, "-") {
+		if len(end) >= len(start) {
+			return end
+		}
+		return start[:len(start)-len(end)] + end
+	}
+	fields := strings.Split(start, "-")
+	endFields := strings.Split(end, "-")
+	if len(endFields) >= len(fields) {
+		return end
+	}
+	return strings.Join(append(fields[:len(fields)-len(endFields)], endFields...), "-")
+}
+
+// splitZone splits the time zone suffix, such as "Z" or "+02:00", off a
+// timestamp. Dates without a time keep their hyphens.
+func splitZone(s string) (string, string) {
+	i := strings.IndexAny(s, "T:")
+	if i < 0 {
+		return s, ""
+	}
+	if j := strings.IndexAny(s[i:], "Z+-"); j >= 0 {
+		return s[:i+j], s[i+j:]
+	}
+	return s, ""
+}
+
+// periodBetween returns the calendar-aware period from a to b, b not before a.
+func periodBetween(a, b time.Time) Period {
+	b = b.In(a.Location())
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	for months > 0 && addMonths(a, months).After(b) {
+		months--
+	}
+	t := addMonths(a, months)
+	days := int(b.Sub(t) / (24 * time.Hour))
+	for days > 0 && t.AddDate(0, 0, days).After(b) {
+		days--
+	}
+	t = t.AddDate(0, 0, days)
+
+	return Period{Years: months / 12, Months: months % 12, Days: days, Clock: b.Sub(t)}
+}
@@ -0,0 +1,107 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration8601(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Period
+	}{
+		{in: "P3DT4H", want: Period{Days: 3, Clock: 4 * time.Hour}},
+		{in: "P1Y2M10DT2H30M", want: Period{Years: 1, Months: 2, Days: 10, Clock: 2*time.Hour + 30*time.Minute}},
+		{in: "P2W", want: Period{Weeks: 2}},
+		{in: "PT36H", want: Period{Clock: 36 * time.Hour}},
+		{in: "PT0.5S", want: Period{Clock: 500 * time.Millisecond}},
+		{in: "PT1,5M", want: Period{Clock: 90 * time.Second}},
+		{in: "P1M", want: Period{Months: 1}},
+		{in: "PT1M", want: Period{Clock: time.Minute}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration8601(tt.in)
+		if err != nil {
+			t.Errorf("ParseDuration8601(\"%s\") failed with %s", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration8601(\"%s\") = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDuration8601Err(t *testing.T) {
+	tests := []string{"", "P", "PT", "P1DT", "3D", "P1H", "PT1D", "P1D2Y", "P1.5D", "P1", "PTT1H", "P1MT1M1M"}
+
+	for _, in := range tests {
+		got, err := ParseDuration8601(in)
+		if err != ErrInvalidDuration {
+			t.Errorf("ParseDuration8601(\"%s\") = %+v, want ErrInvalidDuration", in, got)
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	utc := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		in         string
+		start, end time.Time
+		dur        Period
+	}{
+		{in: "2024-01-01T00:00Z/2024-02-01T00:00Z", start: utc(2024, 1, 1, 0, 0), end: utc(2024, 2, 1, 0, 0), dur: Period{Months: 1}},
+		{in: "2024-01-01/P1M", start: utc(2024, 1, 1, 0, 0), end: utc(2024, 2, 1, 0, 0), dur: Period{Months: 1}},
+		{in: "2024-01-31/P1M", start: utc(2024, 1, 31, 0, 0), end: utc(2024, 2, 29, 0, 0), dur: Period{Months: 1}},
+		{in: "2024-01-31/2024-02-29", start: utc(2024, 1, 31, 0, 0), end: utc(2024, 2, 29, 0, 0), dur: Period{Months: 1}},
+		{in: "P1M/2024-03-31", start: utc(2024, 2, 29, 0, 0), end: utc(2024, 3, 31, 0, 0), dur: Period{Months: 1}},
+		{in: "2023-02-28/P1Y", start: utc(2023, 2, 28, 0, 0), end: utc(2024, 2, 28, 0, 0), dur: Period{Years: 1}},
+		{in: "P1M/2024-03-01", start: utc(2024, 2, 1, 0, 0), end: utc(2024, 3, 1, 0, 0), dur: Period{Months: 1}},
+		{in: "2024-01-31T10:00:00Z/P1DT2H", start: utc(2024, 1, 31, 10, 0), end: utc(2024, 2, 1, 12, 0), dur: Period{Days: 1, Clock: 2 * time.Hour}},
+		{in: "2024-02-15/18", start: utc(2024, 2, 15, 0, 0), end: utc(2024, 2, 18, 0, 0), dur: Period{Days: 3}},
+		{in: "2008-02-15/03-14", start: utc(2008, 2, 15, 0, 0), end: utc(2008, 3, 14, 0, 0), dur: Period{Days: 28}},
+		{in: "2007-12-14T13:30/15:30", start: utc(2007, 12, 14, 13, 30), end: utc(2007, 12, 14, 15, 30), dur: Period{Clock: 2 * time.Hour}},
+		{in: "2024-01-01T10:00+02:00/12:30Z", start: utc(2024, 1, 1, 8, 0), end: utc(2024, 1, 1, 12, 30), dur: Period{Clock: 4*time.Hour + 30*time.Minute}},
+		{in: "2024-01-01T10:00+02:00/12:30", start: utc(2024, 1, 1, 8, 0), end: utc(2024, 1, 1, 10, 30), dur: Period{Clock: 2*time.Hour + 30*time.Minute}},
+		{in: "2024-01-01T10:00:00Z/12:30", start: utc(2024, 1, 1, 10, 0), end: utc(2024, 1, 1, 12, 30), dur: Period{Clock: 2*time.Hour + 30*time.Minute}},
+		{in: "2024-01-01T10:00:00Z/T12:30:15", start: utc(2024, 1, 1, 10, 0), end: utc(2024, 1, 1, 12, 30).Add(15 * time.Second), dur: Period{Clock: 2*time.Hour + 30*time.Minute + 15*time.Second}},
+		{in: "2024-01-01 10:00/12:30", start: utc(2024, 1, 1, 10, 0), end: utc(2024, 1, 1, 12, 30), dur: Period{Clock: 2*time.Hour + 30*time.Minute}},
+		{in: "2024-02-15T09:00Z/18", start: utc(2024, 2, 15, 9, 0), end: utc(2024, 2, 18, 9, 0), dur: Period{Days: 3}},
+		{in: "2024-02-15T09:00Z/16T17:00", start: utc(2024, 2, 15, 9, 0), end: utc(2024, 2, 16, 17, 0), dur: Period{Days: 1, Clock: 8 * time.Hour}},
+		{in: "2023-01-15T00:00Z--2024-03-20T06:00Z", start: utc(2023, 1, 15, 0, 0), end: utc(2024, 3, 20, 6, 0), dur: Period{Years: 1, Months: 2, Days: 5, Clock: 6 * time.Hour}},
+		{in: "2024-W01-1/2024-W02-1", start: utc(2024, 1, 1, 0, 0), end: utc(2024, 1, 8, 0, 0), dur: Period{Days: 7}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.in)
+		if err != nil {
+			t.Errorf("ParseInterval(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+			t.Errorf("ParseInterval(\"%s\") = %v/%v, want %v/%v", tt.in, got.Start, got.End, tt.start, tt.end)
+		}
+		if got.Duration != tt.dur {
+			t.Errorf("ParseInterval(\"%s\").Duration = %+v, want %+v", tt.in, got.Duration, tt.dur)
+		}
+	}
+}
+
+func TestParseIntervalErr(t *testing.T) {
+	tests := []string{
+		"",
+		"2024-01-01",
+		"P1D/P2D",
+		"2024-02-01/2024-01-01",
+		"2024-01-01/P1X",
+		"2024-01-01/2024-13-41",
+		"2024-01-01T10:00Z/09:00",
+	}
+
+	for _, in := range tests {
+		if got, err := ParseInterval(in); err == nil {
+			t.Errorf("ParseInterval(\"%s\") = %+v, want error", in, got)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package jsondates finds the date-valued strings of JSON documents and can
+// rewrite them as normalised timestamps. Documents are read token by token,
+// so they are never held in memory whole.
+package jsondates
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+// Options configures Scan and Rewrite.
+type Options struct {
+	// Parser decides which strings are dates and parses them.
+	Parser goanydate.Parser
+	// Layout formats dates in Rewrite. Defaults to RFC 3339.
+	Layout string
+}
+
+// Match is a date-valued string found in a document.
+type Match struct {
+	// Document is the index of the document in a stream of several.
+	Document int
+	// Path locates the value, as in $.orders[0].created.
+	Path   string
+	Value  string
+	Layout string
+	Time   time.Time
+}
+
+// Scan reads a stream of JSON documents, such as a single document or JSON
+// lines, and returns every string value that is a date. Object keys are not
+// considered.
+func Scan(r io.Reader, opts Options) ([]Match, error) {
+	return walk(r, nil, opts)
+}
+
+// Rewrite copies a stream of JSON documents from r to w with every date
+// replaced by its time in opts.Layout, and returns the dates it replaced.
+// The output is compact, with one document per line.
+func Rewrite(w io.Writer, r io.Reader, opts Options) ([]Match, error) {
+	bw := bufio.NewWriter(w)
+	matches, err := walk(r, bw, opts)
+	if ferr := bw.Flush(); err == nil {
+		err = ferr
+	}
+	return matches, err
+}
+
+// frame is an object or array being walked.
+type frame struct {
+	object bool
+	path   string
+	key    string
+	index  int
+	// n counts the values written, for commas.
+	n       int
+	wantKey bool
+}
+
+func (f *frame) child() string {
+	if !f.object {
+		return f.path + "[" + strconv.Itoa(f.index) + "]"
+	}
+	if isIdentifier(f.key) {
+		return f.path + "." + f.key
+	}
+	return f.path + "[" + strconv.Quote(f.key) + "]"
+}
+
+func isIdentifier(s string) bool {
+	for i, r := range s {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func walk(r io.Reader, w *bufio.Writer, opts Options) ([]Match, error) {
+	layout := opts.Layout
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var matches []Match
+	var stack []*frame
+	doc := 0
+	write := func(s string) {
+		if w != nil {
+			w.WriteString(s)
+		}
+	}
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) && len(stack) == 0 {
+			return matches, nil
+		}
+		if err != nil {
+			return matches, err
+		}
+
+		var top *frame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			write(d.String())
+			stack = stack[:len(stack)-1]
+			doc = endValue(stack, doc, write)
+			continue
+		}
+		if top != nil && top.wantKey {
+			top.key = tok.(string)
+			if top.n > 0 {
+				write(",")
+			}
+			write(quote(top.key) + ":")
+			top.wantKey = false
+			continue
+		}
+		if top != nil && !top.object && top.n > 0 {
+			write(",")
+		}
+
+		path := "$"
+		if top != nil {
+			path = top.child()
+		}
+		switch v := tok.(type) {
+		case json.Delim:
+			write(v.String())
+			stack = append(stack, &frame{object: v == '{', path: path, wantKey: v == '{'})
+			continue
+		case string:
+			if opts.Parser.IsDate(v) {
+				if res, err := opts.Parser.ParseResult(v); err == nil {
+					matches = append(matches, Match{Document: doc, Path: path, Value: v, Layout: res.Layout, Time: res.Time})
+					v = res.Time.Format(layout)
+				}
+			}
+			write(quote(v))
+		case json.Number:
+			write(v.String())
+		case bool:
+			write(strconv.FormatBool(v))
+		case nil:
+			write("null")
+		}
+		doc = endValue(stack, doc, write)
+	}
+}
+
+// endValue moves past a value written in the innermost frame, or past a
+// whole document when there is none, and returns the document index.
+func endValue(stack []*frame, doc int, write func(string)) int {
+	if len(stack) == 0 {
+		write("\n")
+		return doc + 1
+	}
+	top := stack[len(stack)-1]
+	top.n++
+	if top.object {
+		top.wantKey = true
+	} else {
+		top.index++
+	}
+	return doc
+}
+
+// quote encodes s as a JSON string without escaping HTML characters.
+func quote(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return string(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,71 @@
+package jsondates
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const doc = `{
+  "id": 42,
+  "created": "Nov 24, 2024",
+  "version": "1.2",
+  "orders": [
+    {"placed": "2024-11-24T15:04:05+09:00", "note": "<ok> & done", "paid": true},
+    {"placed": "24/11/2024", "tags": ["2024-11-25", "x"], "extra": null}
+  ],
+  "odd key": "2024-W47-7",
+  "2024-11-26": "key is not a date value",
+  "amount": 1.50
+}`
+
+func TestScan(t *testing.T) {
+	matches, err := Scan(strings.NewReader(doc), Options{})
+	if err != nil {
+		t.Fatalf("Scan failed with %s", err)
+	}
+
+	want := []Match{
+		{Path: "$.created", Value: "Nov 24, 2024", Layout: "Jan 02, 2006", Time: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{Path: "$.orders[0].placed", Value: "2024-11-24T15:04:05+09:00", Layout: "2006-01-02T15:04:05-07:00", Time: time.Date(2024, time.November, 24, 6, 4, 5, 0, time.UTC)},
+		{Path: "$.orders[1].placed", Value: "24/11/2024", Layout: "02/01/2006", Time: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{Path: "$.orders[1].tags[0]", Value: "2024-11-25", Layout: "2006-01-02", Time: time.Date(2024, time.November, 25, 0, 0, 0, 0, time.UTC)},
+		{Path: `$["odd key"]`, Value: "2024-W47-7", Time: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("Scan = %+v, want %+v", matches, want)
+	}
+	for i, m := range matches {
+		w := want[i]
+		if m.Path != w.Path || m.Value != w.Value || m.Layout != w.Layout || !m.Time.Equal(w.Time) {
+			t.Errorf("Scan match %d = %+v, want %+v", i, m, w)
+		}
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	in := doc + "\n" + `["Nov 24, 2024", 3]`
+	var out strings.Builder
+	matches, err := Rewrite(&out, strings.NewReader(in), Options{Layout: time.DateOnly})
+	if err != nil {
+		t.Fatalf("Rewrite failed with %s", err)
+	}
+
+	want := `{"id":42,"created":"2024-11-24","version":"1.2","orders":[{"placed":"2024-11-24","note":"<ok> & done","paid":true},{"placed":"2024-11-24","tags":["2024-11-25","x"],"extra":null}],"odd key":"2024-11-24","2024-11-26":"key is not a date value","amount":1.50}
+["2024-11-24",3]
+`
+	if out.String() != want {
+		t.Errorf("Rewrite = %s, want %s", out.String(), want)
+	}
+	if len(matches) != 6 || matches[5].Document != 1 || matches[5].Path != "$[0]" {
+		t.Errorf("Rewrite matches = %+v, want 6 with the last in document 1", matches)
+	}
+}
+
+func TestScanErr(t *testing.T) {
+	for _, in := range []string{`{"a": "2024-11-24"`, `{"a" 1}`, `[1,]`} {
+		if _, err := Scan(strings.NewReader(in), Options{}); err == nil {
+			t.Errorf("Scan(%s) succeeded, want error", in)
+		}
+	}
+}
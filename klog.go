@@ -0,0 +1,77 @@
+package goanydate
+
+import (
+	"strings"
+	"time"
+)
+
+var klogSeverities = map[string]string{
+	"I": "INFO",
+	"W": "WARNING",
+	"E": "ERROR",
+	"F": "FATAL",
+}
+
+// ParseKlog parses the header of a klog or glog line with the zero Parser.
+func ParseKlog(line string) (Result, error) {
+	p := Parser{}
+	return p.ParseKlog(line)
+}
+
+// ParseKlog parses the header of a Kubernetes klog or glog line:
+//
+//	I1124 15:04:05.123456   12345 server.go:42] msg
+//
+// The year, absent from the classic header, is inferred with the parser's
+// YearPolicy; newer glog headers carrying "I20241124" keep their own year.
+// The severity, thread id and source location are returned in Result.Fields
+// under "severity", "thread" and "source".
+func (p *Parser) ParseKlog(line string) (Result, error) {
+	end := strings.IndexByte(line, ']')
+	if end < 0 {
+		return Result{}, ErrInvalidDateFormat
+	}
+	fields := strings.Fields(line[:end])
+	if len(fields) != 4 || len(fields[0]) < 5 {
+		return Result{}, ErrInvalidDateFormat
+	}
+	severity, ok := klogSeverities[fields[0][:1]]
+	if !ok || !isNumber(fields[0][1:]) || !isNumber(fields[2]) || !strings.Contains(fields[3], ":") {
+		return Result{}, ErrInvalidDateFormat
+	}
+
+	// the date is fused to the severity, so it is laid out here rather
+	// than by the detector, which only sees the time
+	var date string
+	switch len(fields[0]) - 1 {
+	case 4:
+		date = "0102"
+	case 8:
+		date = "20060102"
+	default:
+		return Result{}, ErrInvalidDateFormat
+	}
+	d := adDetector{}
+	clock, err := d.extractComponents(fields[1])
+	if err != nil || !hasComponent(clock, ctHour) || hasComponent(clock, ctYear, ctMonth, ctMonthNum, ctDay) {
+		return Result{}, ErrInvalidDateFormat
+	}
+
+	layout := fields[0][:1] + date + " " + d.goFmt(clock)
+	t, err := time.ParseInLocation(layout, fields[0]+" "+fields[1], p.location())
+	if err != nil {
+		return Result{}, ErrInvalidDateFormat
+	}
+	r := Result{Time: t, Layout: layout}
+	if date == "0102" {
+		r.Time = inferYear(t, p.now(), p.YearPolicy)
+		r.YearInferred = true
+	}
+	r.Fields = map[string]string{
+		"severity": severity,
+		"thread":   fields[2],
+		"source":   fields[3],
+	}
+
+	return r, nil
+}
@@ -0,0 +1,78 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseKlog(t *testing.T) {
+	ref := time.Date(2024, time.November, 30, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in       string
+		want     time.Time
+		inferred bool
+		layout   string
+		fields   map[string]string
+	}{
+		{
+			in:       "I1124 15:04:05.123456   12345 server.go:42] msg",
+			want:     time.Date(2024, time.November, 24, 15, 4, 5, 123456000, time.UTC),
+			inferred: true,
+			layout:   "I0102 15:04:05.999999",
+			fields:   map[string]string{"severity": "INFO", "thread": "12345", "source": "server.go:42"},
+		},
+		{
+			in:       "E0102 03:04:05.000000       1 controller.go:114] error syncing: boom]",
+			want:     time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC),
+			inferred: true,
+			layout:   "E0102 15:04:05.000000",
+			fields:   map[string]string{"severity": "ERROR", "thread": "1", "source": "controller.go:114"},
+		},
+		{
+			in:     "W20230615 08:00:00.500000 777 main.cc:9] low disk",
+			want:   time.Date(2023, time.June, 15, 8, 0, 0, 500000000, time.UTC),
+			layout: "W20060102 15:04:05.000000",
+			fields: map[string]string{"severity": "WARNING", "thread": "777", "source": "main.cc:9"},
+		},
+	}
+
+	p := Parser{Now: func() time.Time { return ref }}
+	for _, tt := range tests {
+		got, err := p.ParseKlog(tt.in)
+		if err != nil {
+			t.Errorf("ParseKlog(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want) {
+			t.Errorf("ParseKlog(\"%s\").Time = %v, want %v", tt.in, got.Time, tt.want)
+		}
+		if got.Layout != tt.layout {
+			t.Errorf("ParseKlog(\"%s\").Layout = %s, want %s", tt.in, got.Layout, tt.layout)
+		}
+		if got.YearInferred != tt.inferred {
+			t.Errorf("ParseKlog(\"%s\").YearInferred = %v, want %v", tt.in, got.YearInferred, tt.inferred)
+		}
+		for k, v := range tt.fields {
+			if got.Fields[k] != v {
+				t.Errorf("ParseKlog(\"%s\").Fields[%s] = %s, want %s", tt.in, k, got.Fields[k], v)
+			}
+		}
+	}
+}
+
+func TestParseKlogErr(t *testing.T) {
+	tests := []string{
+		"",
+		"I1124 15:04:05.123456 12345 server.go:42 msg",
+		"X1124 15:04:05.123456 12345 server.go:42] msg",
+		"I1324 15:04:05.123456 12345 server.go:42] msg",
+		"I1124 15:04:05.123456 main server.go:42] msg",
+	}
+
+	for _, in := range tests {
+		got, err := ParseKlog(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("ParseKlog(\"%s\") = %+v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
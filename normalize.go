@@ -0,0 +1,97 @@
+package goanydate
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Normalized is an input rewritten for detection: every Unicode decimal digit
+// becomes its ASCII digit and full-width or typographic separators become
+// their ASCII forms, so "２０２４－１１－２４" reads as "2024-11-24".
+type Normalized struct {
+	Text string
+	// offsets holds, for each byte of Text and one past its end, the byte
+	// offset in the original string. It is nil when Text is unchanged.
+	offsets []int
+}
+
+// Normalize rewrites s for detection. Parse, DetectFormat and the other
+// parsing functions normalise their input themselves.
+func Normalize(s string) Normalized {
+	i := 0
+	for i < len(s) && s[i] < utf8.RuneSelf {
+		i++
+	}
+	if i == len(s) {
+		return Normalized{Text: s}
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	offsets := make([]int, 0, len(s)+1)
+	for i, r := range s {
+		r = normalizeRune(r)
+		if r < 0 {
+			continue
+		}
+		n := b.Len()
+		b.WriteRune(r)
+		for ; n < b.Len(); n++ {
+			offsets = append(offsets, i)
+		}
+	}
+	offsets = append(offsets, len(s))
+
+	return Normalized{Text: b.String(), offsets: offsets}
+}
+
+// OriginalOffset maps a byte offset in Text to the offset of the same
+// character in the original string.
+func (n Normalized) OriginalOffset(i int) int {
+	if n.offsets == nil || i < 0 {
+		return i
+	}
+	if i >= len(n.offsets) {
+		return n.offsets[len(n.offsets)-1]
+	}
+	return n.offsets[i]
+}
+
+// normalizeRune returns the ASCII form of r, r itself, or -1 for
+// invisible direction marks, which are dropped.
+func normalizeRune(r rune) rune {
+	switch {
+	case r < utf8.RuneSelf:
+		return r
+	case unicode.Is(unicode.Nd, r):
+		return '0' + digitValue(r)
+	case r >= 0xFF01 && r <= 0xFF5E: // full-width ASCII
+		return r - 0xFEE0
+	case r == 0x3000 || r == 0x00A0 || r == 0x202F: // ideographic and no-break spaces
+		return ' '
+	case r == 0x2010 || r == 0x2011 || r == 0x2212: // hyphens and minus sign
+		return '-'
+	case r == 0x066B: // Arabic decimal separator
+		return '.'
+	case r == 0x200E || r == 0x200F || r == 0x061C: // direction marks
+		return -1
+	}
+	return r
+}
+
+// digitValue returns the value of the decimal digit r. Unicode allocates
+// decimal digits in contiguous runs starting at zero.
+func digitValue(r rune) rune {
+	for _, rr := range unicode.Nd.R16 {
+		if r >= rune(rr.Lo) && r <= rune(rr.Hi) {
+			return (r - rune(rr.Lo)) % 10
+		}
+	}
+	for _, rr := range unicode.Nd.R32 {
+		if r >= rune(rr.Lo) && r <= rune(rr.Hi) {
+			return (r - rune(rr.Lo)) % 10
+		}
+	}
+	return 0
+}
@@ -0,0 +1,82 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "2024-11-24", want: "2024-11-24"},
+		{in: "٢٠٢٤-١١-٢٤", want: "2024-11-24"},
+		{in: "۲۰۲۴/۱۱/۲۴", want: "2024/11/24"},
+		{in: "２０２４－１１－２４", want: "2024-11-24"},
+		{in: "２０２４年１１月２４日", want: "2024年11月24日"},
+		{in: "१५：३०", want: "15:30"},
+		{in: "๒๔ พ.ย. ๒๕๖๗", want: "24 พ.ย. 2567"},
+		{in: "2024‏-11-24", want: "2024-11-24"},
+		{in: "24 Nov　2024", want: "24 Nov 2024"},
+		{in: "𝟐𝟎𝟐𝟒", want: "2024"},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.in).Text; got != tt.want {
+			t.Errorf("Normalize(\"%s\") = \"%s\", want \"%s\"", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeOffset(t *testing.T) {
+	in := "٢٠٢٤-١١-٢٤ 10:00"
+	n := Normalize(in)
+	tests := []struct {
+		offset int
+		want   int
+	}{
+		{offset: 0, want: 0},
+		{offset: 1, want: 2},
+		{offset: 4, want: 8},
+		{offset: 5, want: 9},
+		{offset: 11, want: 19},
+		{offset: 16, want: len(in)},
+		{offset: 100, want: len(in)},
+	}
+
+	for _, tt := range tests {
+		if got := n.OriginalOffset(tt.offset); got != tt.want {
+			t.Errorf("OriginalOffset(%d) = %d, want %d", tt.offset, got, tt.want)
+		}
+	}
+	if got := Normalize("2024").OriginalOffset(3); got != 3 {
+		t.Errorf("OriginalOffset(3) = %d, want 3", got)
+	}
+}
+
+func TestParseNormalized(t *testing.T) {
+	tests := []struct {
+		in     string
+		layout string
+		want   time.Time
+	}{
+		{in: "٢٠٢٤-١١-٢٤", layout: "2006-01-02", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "２０２４－１１－２４", layout: "2006-01-02", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "２０２４／１１／２４　１５：３０", layout: "2006/01/02 15:04", want: time.Date(2024, time.November, 24, 15, 30, 0, 0, time.UTC)},
+		{in: "२४ Nov २०२४", layout: "02 Jan 2006", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "令和６年１１月２４日", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{}
+		r, err := p.ParseResult(tt.in)
+		if err != nil {
+			t.Errorf("ParseResult(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !r.Time.Equal(tt.want) || r.Layout != tt.layout {
+			t.Errorf("ParseResult(\"%s\") = %v, %s, want %v, %s", tt.in, r.Time, r.Layout, tt.want, tt.layout)
+		}
+	}
+}
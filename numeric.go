@@ -0,0 +1,151 @@
+package goanydate
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NumericFormat is a set of numeric date encodings, such as spreadsheet
+// serial numbers. They are indistinguishable from plain numbers, so the
+// parser only accepts the ones enabled in Parser.Numeric.
+type NumericFormat uint16
+
+const (
+	// NumericExcel1900 is Excel's default date system: 1 is 1900-01-01 and,
+	// as in Lotus 1-2-3, 60 is the nonexistent 1900-02-29.
+	NumericExcel1900 NumericFormat = 1 << iota
+	// NumericExcel1904 is the 1904 date system of classic Mac Excel: 0 is 1904-01-01.
+	NumericExcel1904
+	// NumericLibreOffice is the LibreOffice and OpenOffice serial number: 0 is 1899-12-30.
+	NumericLibreOffice
+	// NumericJulianDay is the astronomical Julian day, counted from noon UTC:
+	// 2460638.5 is 2024-11-24T00:00Z. Only days from year 1 on are accepted.
+	NumericJulianDay
+	// NumericModifiedJulianDay is the Julian day minus 2400000.5: 0 is 1858-11-17T00:00Z.
+	NumericModifiedJulianDay
+	// NumericCYYDDD is the packed date of IBM midrange and JD Edwards systems:
+	// century since 1900, two-digit year and day of year, so 124331 is 2024-11-26.
+	NumericCYYDDD
+)
+
+// numericFormats lists the encodings in the order they are tried, from the
+// narrowest range of accepted values to the widest: Julian days only cover
+// seven-digit numbers, CYYDDD five- and six-digit ones with a valid day of
+// year, and the serial numbers everything from 0 or 1 up.
+var numericFormats = []NumericFormat{
+	NumericJulianDay,
+	NumericCYYDDD,
+	NumericExcel1900,
+	NumericExcel1904,
+	NumericLibreOffice,
+	NumericModifiedJulianDay,
+}
+
+var (
+	excelEpoch  = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	excel1904   = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	excelMaxDay = 2958465 // 9999-12-31 in the 1900 date system
+	mjdEpoch    = time.Date(1858, time.November, 17, 0, 0, 0, 0, time.UTC)
+	jdMin       = 1721425.5 // 0001-01-01T00:00Z
+	jdMax       = 5373484.5 // 10000-01-01T00:00Z, excluded
+	mjdOffset   = 2400000   // whole days between the JD and MJD epochs, less half a day
+)
+
+// isDecimal reports whether v is an unsigned decimal number such as "45620.6458".
+func isDecimal(v string) bool {
+	whole, frac, _ := strings.Cut(v, ".")
+	return isASCIIDigits(whole) && (frac == "" || isASCIIDigits(frac))
+}
+
+// splitDays splits a serial number into whole days and the time of day.
+func splitDays(v string) (int, time.Duration, bool) {
+	whole, frac, _ := strings.Cut(v, ".")
+	days, err := strconv.Atoi(whole)
+	if err != nil {
+		return 0, 0, false
+	}
+	var clock time.Duration
+	if frac != "" {
+		f, err := strconv.ParseFloat("0."+frac, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		clock = time.Duration(math.Round(f*86400*1000)) * time.Millisecond
+	}
+	return days, clock, true
+}
+
+// fromNumeric converts v in the encoding f to a time in loc. Julian days are
+// always in UTC.
+func fromNumeric(v string, f NumericFormat, loc *time.Location) (time.Time, bool) {
+	if !isDecimal(v) {
+		return time.Time{}, false
+	}
+	days, clock, ok := splitDays(v)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	var t time.Time
+	switch f {
+	case NumericExcel1900:
+		if days < 1 || days == 60 || days > excelMaxDay {
+			return time.Time{}, false
+		}
+		if days < 60 {
+			days++ // before the phantom 1900-02-29
+		}
+		t = excelEpoch.AddDate(0, 0, days)
+	case NumericExcel1904:
+		if days > excelMaxDay-1462 {
+			return time.Time{}, false
+		}
+		t = excel1904.AddDate(0, 0, days)
+	case NumericLibreOffice:
+		if days > excelMaxDay {
+			return time.Time{}, false
+		}
+		t = excelEpoch.AddDate(0, 0, days)
+	case NumericJulianDay:
+		if jd := float64(days) + clock.Hours()/24; jd < jdMin || jd >= jdMax {
+			return time.Time{}, false
+		}
+		// Julian days start at noon
+		return mjdEpoch.AddDate(0, 0, days-mjdOffset-1).Add(12*time.Hour + clock), true
+	case NumericModifiedJulianDay:
+		if float64(days) >= jdMax-float64(mjdOffset)-0.5 {
+			return time.Time{}, false
+		}
+		return mjdEpoch.AddDate(0, 0, days).Add(clock), true
+	case NumericCYYDDD:
+		if clock != 0 || len(v) < 5 || len(v) > 6 {
+			return time.Time{}, false
+		}
+		year := 1900 + days/1000
+		yday := days % 1000
+		if yday < 1 || yday > 366 || yday == 366 && !isLeap(year) {
+			return time.Time{}, false
+		}
+		t = time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}, false
+	}
+
+	t = t.Add(clock)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc), true
+}
+
+// parseNumeric parses input with the first enabled numeric encoding that accepts it.
+func (p *Parser) parseNumeric(input string, enabled NumericFormat) (Result, bool) {
+	for _, f := range numericFormats {
+		if enabled&f == 0 {
+			continue
+		}
+		if t, ok := fromNumeric(input, f, p.location()); ok {
+			return Result{Time: t}, true
+		}
+	}
+	return Result{}, false
+}
@@ -0,0 +1,79 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNumeric(t *testing.T) {
+	tests := []struct {
+		in      string
+		numeric NumericFormat
+		want    time.Time
+	}{
+		{in: "45620", numeric: NumericExcel1900, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "45620.6458", numeric: NumericExcel1900, want: time.Date(2024, time.November, 24, 15, 29, 57, 120000000, time.UTC)},
+		{in: "45620.5", numeric: NumericExcel1900, want: time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC)},
+		{in: "1", numeric: NumericExcel1900, want: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "59", numeric: NumericExcel1900, want: time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{in: "61", numeric: NumericExcel1900, want: time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "44158", numeric: NumericExcel1904, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "0", numeric: NumericExcel1904, want: time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "45620", numeric: NumericLibreOffice, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "59", numeric: NumericLibreOffice, want: time.Date(1900, time.February, 27, 0, 0, 0, 0, time.UTC)},
+		{in: "0", numeric: NumericLibreOffice, want: time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{in: "0", numeric: NumericExcel1900 | NumericLibreOffice, want: time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{in: "2460638.5", numeric: NumericJulianDay, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "2460639", numeric: NumericJulianDay, want: time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC)},
+		{in: "2451545.0", numeric: NumericJulianDay, want: time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "1721425.5", numeric: NumericJulianDay, want: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "5373484.25", numeric: NumericJulianDay, want: time.Date(9999, time.December, 31, 18, 0, 0, 0, time.UTC)},
+		{in: "60638", numeric: NumericModifiedJulianDay, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "51544.5", numeric: NumericModifiedJulianDay, want: time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "124331", numeric: NumericCYYDDD, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "99001", numeric: NumericCYYDDD, want: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "124366", numeric: NumericCYYDDD, want: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{in: "124331", numeric: NumericCYYDDD | NumericExcel1900, want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "2460638.5", numeric: NumericJulianDay | NumericModifiedJulianDay, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "2024-11-24", numeric: NumericExcel1900, want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Numeric: tt.numeric}
+		got, err := p.Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseNumericErr(t *testing.T) {
+	tests := []struct {
+		in      string
+		numeric NumericFormat
+	}{
+		{in: "45620"},
+		{in: "60", numeric: NumericExcel1900},
+		{in: "0", numeric: NumericExcel1900},
+		{in: "2958466", numeric: NumericExcel1900},
+		{in: "-1", numeric: NumericExcel1900},
+		{in: "60638", numeric: NumericJulianDay},
+		{in: "1721425.25", numeric: NumericJulianDay},
+		{in: "5373484.5", numeric: NumericJulianDay},
+		{in: "123366", numeric: NumericCYYDDD},
+		{in: "124000", numeric: NumericCYYDDD},
+		{in: "124331.5", numeric: NumericCYYDDD},
+		{in: "1234567", numeric: NumericCYYDDD},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Numeric: tt.numeric}
+		if got, err := p.Parse(tt.in); err != ErrInvalidDateFormat {
+			t.Errorf("Parse(\"%s\") = %v, want ErrInvalidDateFormat", tt.in, got)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package goanydate
+
+import (
+	"strings"
+)
+
+// DateOrder says how to read all-numeric dates such as "03/04/2024", which
+// the detector otherwise reads month first unless the month is over 12.
+type DateOrder uint8
+
+const (
+	// OrderAuto reads the month first, swapping day and month only when
+	// the first number cannot be a month.
+	OrderAuto DateOrder = iota
+	// OrderMDY reads month, day, year, as in the United States. Dates that
+	// do not fit, such as "13/04/2024", are rejected.
+	OrderMDY
+	// OrderDMY reads day, month, year. Dates starting with a four-digit
+	// year are still read year, month, day. Dates that do not fit are
+	// rejected.
+	OrderDMY
+	// OrderYMD reads year, month, day, including two-digit years as in
+	// "24/11/05". Dates that do not fit are rejected.
+	OrderYMD
+)
+
+// ParseDateOrder returns the order named "mdy", "dmy" or "ymd", in any case.
+func ParseDateOrder(s string) (DateOrder, bool) {
+	switch strings.ToLower(s) {
+	case "", "auto":
+		return OrderAuto, true
+	case "mdy":
+		return OrderMDY, true
+	case "dmy":
+		return OrderDMY, true
+	case "ymd":
+		return OrderYMD, true
+	}
+	return OrderAuto, false
+}
+
+// reorder applies d.order to the month and day of an all-numeric date,
+// which extractComponents reads month first.
+func (d *adDetector) reorder(result []adComponent, componentsMap map[componentType]int) {
+	m, okM := componentsMap[ctMonthNum]
+	dd, okD := componentsMap[ctDay]
+	if !okM || !okD || m > dd || result[m].Type != ctMonthNum || result[dd].Type != ctDay {
+		return
+	}
+	y, okY := componentsMap[ctYear]
+
+	switch d.order {
+	case OrderDMY:
+		if okY && y < m {
+			return
+		}
+		result[m].Type, result[dd].Type = ctDay, ctMonthNum
+		componentsMap[ctMonthNum], componentsMap[ctDay] = dd, m
+	case OrderYMD:
+		if !okY || y < dd || len(result[y].Value) != 2 {
+			return
+		}
+		result[m].Type, result[dd].Type, result[y].Type = ctYear, ctMonthNum, ctDay
+		componentsMap[ctYear], componentsMap[ctMonthNum], componentsMap[ctDay] = m, dd, y
+	}
+}
@@ -0,0 +1,78 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOrder(t *testing.T) {
+	tests := []struct {
+		in     string
+		order  DateOrder
+		want   time.Time
+		layout string
+	}{
+		{in: "03/04/2024", order: OrderAuto, want: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), layout: "01/02/2006"},
+		{in: "03/04/2024", order: OrderMDY, want: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), layout: "01/02/2006"},
+		{in: "03/04/2024", order: OrderDMY, want: time.Date(2024, time.April, 3, 0, 0, 0, 0, time.UTC), layout: "02/01/2006"},
+		{in: "03.04.2024 10:30", order: OrderDMY, want: time.Date(2024, time.April, 3, 10, 30, 0, 0, time.UTC), layout: "02.01.2006 15:04"},
+		{in: "13/04/2024", order: OrderAuto, want: time.Date(2024, time.April, 13, 0, 0, 0, 0, time.UTC), layout: "02/01/2006"},
+		{in: "01/13/2024", order: OrderMDY, want: time.Date(2024, time.January, 13, 0, 0, 0, 0, time.UTC), layout: "01/02/2006"},
+		{in: "2024-03-04", order: OrderDMY, want: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), layout: "2006-01-02"},
+		{in: "24/11/05", order: OrderYMD, want: time.Date(2024, time.November, 5, 0, 0, 0, 0, time.UTC), layout: "06/01/02"},
+		{in: "24/11/05", order: OrderDMY, want: time.Date(2005, time.November, 24, 0, 0, 0, 0, time.UTC), layout: "02/01/06"},
+		{in: "4 Mar 2024", order: OrderDMY, want: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), layout: "2 Jan 2006"},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Order: tt.order}
+		r, err := p.ParseResult(tt.in)
+		if err != nil {
+			t.Errorf("ParseResult(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !r.Time.Equal(tt.want) || r.Layout != tt.layout {
+			t.Errorf("ParseResult(\"%s\") = %v, %s, want %v, %s", tt.in, r.Time, r.Layout, tt.want, tt.layout)
+		}
+	}
+}
+
+func TestParseOrderErr(t *testing.T) {
+	tests := []struct {
+		in    string
+		order DateOrder
+	}{
+		{in: "13/01/2024", order: OrderMDY},
+		{in: "01/13/2024", order: OrderDMY},
+		{in: "13.01.2024 10:30", order: OrderMDY},
+		{in: "2024-13-01", order: OrderDMY},
+		{in: "24/13/05", order: OrderYMD},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Order: tt.order}
+		if r, err := p.ParseResult(tt.in); err != ErrInvalidDateFormat {
+			t.Errorf("ParseResult(\"%s\") = %v, %s, want ErrInvalidDateFormat", tt.in, r.Time, r.Layout)
+		}
+	}
+}
+
+func TestParseDateOrder(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DateOrder
+		ok   bool
+	}{
+		{in: "", want: OrderAuto, ok: true},
+		{in: "DMY", want: OrderDMY, ok: true},
+		{in: "mdy", want: OrderMDY, ok: true},
+		{in: "ymd", want: OrderYMD, ok: true},
+		{in: "ydm", want: OrderAuto, ok: false},
+	}
+
+	for _, tt := range tests {
+		if got, ok := ParseDateOrder(tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("ParseDateOrder(\"%s\") = %d, %t, want %d, %t", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package goanydate
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Parser parses dates found by the detector and fills in what the input
+// leaves out. The zero value is ready to use.
+type Parser struct {
+	// Now returns the reference time for year inference. Defaults to time.Now.
+	Now func() time.Time
+	// YearPolicy selects the year of dates written without one.
+	YearPolicy YearPolicy
+	// Location is used for inputs without a time zone. Defaults to UTC.
+	Location *time.Location
+	// Numeric enables numeric encodings such as Excel serial dates. Plain
+	// numbers are read in the first enabled encoding that accepts them.
+	Numeric NumericFormat
+	// Calendar, when set, reads the year, month and day of inputs in a
+	// non-Gregorian calendar such as Persian. Results have no layout.
+	Calendar Calendar
+	// Order says how to read all-numeric dates such as "03/04/2024".
+	Order DateOrder
+}
+
+// Result describes a parsed date.
+type Result struct {
+	Time time.Time
+	// Layout is the Go layout detected for the input, empty for formats
+	// without one such as ISO 8601 week dates or serial numbers.
+	Layout string
+	// YearInferred reports that the input had no year and Time carries the
+	// year chosen by the parser's YearPolicy.
+	YearInferred bool
+	// Fields holds extra values of structured formats, such as the klog severity.
+	Fields map[string]string
+}
+
+// Parse detects the format of input and parses it with the zero Parser.
+func Parse(input string) (time.Time, error) {
+	p := Parser{}
+	return p.Parse(input)
+}
+
+// Parse detects the format of input and parses it.
+func (p *Parser) Parse(input string) (time.Time, error) {
+	r, err := p.ParseResult(input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return r.Time, nil
+}
+
+// ParseResult detects the format of input and parses it, reporting the layout
+// and whatever the parser had to infer.
+func (p *Parser) ParseResult(input string) (Result, error) {
+	input = strings.TrimSpace(Normalize(input).Text)
+
+	if p.Numeric != 0 && isDecimal(input) {
+		if r, ok := p.parseNumeric(input, p.Numeric); ok {
+			return r, nil
+		}
+	}
+	if p.Calendar != nil {
+		return p.parseCalendar(input)
+	}
+	if r, ok, err := p.parseWeekDate(input); ok {
+		return r, err
+	}
+	if r, ok, err := p.parseEraDate(input); ok {
+		return r, err
+	}
+	if layout, ok := detectHTTPDate(input); ok {
+		t, err := time.Parse(layout, input)
+		if err != nil {
+			return Result{}, ErrInvalidDateFormat
+		}
+		return Result{Time: t, Layout: layout}, nil
+	}
+
+	d := adDetector{order: p.Order}
+	components, err := d.extractComponents(input)
+	if err != nil {
+		return Result{}, err
+	}
+
+	layout := d.goFmt(components)
+	t, err := time.ParseInLocation(layout, input, p.location())
+	if err != nil {
+		return Result{}, ErrInvalidDateFormat
+	}
+
+	r := Result{Time: t, Layout: layout}
+	if !hasComponent(components, ctYear) && hasComponent(components, ctMonth, ctMonthNum) {
+		r.Time = inferYear(t, p.now(), p.YearPolicy)
+		r.YearInferred = true
+	}
+
+	return r, nil
+}
+
+// Candidates returns every layout input could be in with the zero Parser.
+func Candidates(input string) ([]string, error) {
+	p := Parser{}
+	return p.Candidates(input)
+}
+
+// Candidates returns every layout input could be in, the one Parse uses
+// first. All-numeric dates such as "03/04/2024" have a candidate for each
+// date order they can be read in. Inputs that parse but have no layout
+// return ErrNoLayout.
+func (p *Parser) Candidates(input string) ([]string, error) {
+	var layouts []string
+	parsed := false
+	orders := []DateOrder{p.Order}
+	for _, order := range []DateOrder{OrderMDY, OrderDMY, OrderYMD} {
+		if order != p.Order {
+			orders = append(orders, order)
+		}
+	}
+	for _, order := range orders {
+		q := *p
+		q.Order = order
+		r, err := q.ParseResult(input)
+		if err != nil {
+			continue
+		}
+		parsed = true
+		if r.Layout != "" && !slices.Contains(layouts, r.Layout) {
+			layouts = append(layouts, r.Layout)
+		}
+	}
+
+	switch {
+	case len(layouts) > 0:
+		return layouts, nil
+	case parsed:
+		return nil, ErrNoLayout
+	}
+	return nil, ErrInvalidDateFormat
+}
+
+// IsDate reports whether input is a calendar date, possibly with a time. Bare
+// numbers, years, times of day and month-day pairs such as "1.2" are
+// rejected even though Parse accepts them, while "Nov 24" is a date.
+func (p *Parser) IsDate(input string) bool {
+	r, err := p.ParseResult(input)
+	if err != nil {
+		return false
+	}
+	if r.Layout == "" {
+		// week, era, calendar and numeric dates
+		return true
+	}
+
+	d := adDetector{order: p.Order}
+	components, err := d.extractComponents(strings.TrimSpace(Normalize(input).Text))
+	if err != nil || !hasComponent(components, ctDay, ctYearDay) {
+		return false
+	}
+	return hasComponent(components, ctMonth, ctYearDay) || hasComponent(components, ctYear) && hasComponent(components, ctMonthNum)
+}
+
+func (p *Parser) now() time.Time {
+	if p.Now != nil {
+		return p.Now()
+	}
+	return time.Now()
+}
+
+func (p *Parser) location() *time.Location {
+	if p.Location != nil {
+		return p.Location
+	}
+	return time.UTC
+}
@@ -0,0 +1,143 @@
+package goanydate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	ref := time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in       string
+		policy   YearPolicy
+		want     time.Time
+		inferred bool
+	}{
+		{in: "2024-11-14 22:43:57", want: time.Date(2024, time.November, 14, 22, 43, 57, 0, time.UTC)},
+		{in: "2024-11-26T12:08:05+0900", want: time.Date(2024, time.November, 26, 3, 8, 5, 0, time.UTC)},
+		{in: "Sun, 06 Nov 1994 08:49:37 GMT", want: time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)},
+		{in: "Nov 26", want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "26 Nov", want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "Nov 23 3:01pm", want: time.Date(2024, time.November, 23, 15, 1, 0, 0, time.UTC), inferred: true},
+		{in: "Nov 23 15:04:05.988", want: time.Date(2024, time.November, 23, 15, 4, 5, 988000000, time.UTC), inferred: true},
+		{in: "Jan 3", want: time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "Jan 3", policy: YearPast, want: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "Nov 23", policy: YearFuture, want: time.Date(2025, time.November, 23, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "Feb 29", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "2024-331", want: time.Date(2024, time.November, 26, 0, 0, 0, 0, time.UTC)},
+		{in: "2024331T12:00Z", want: time.Date(2024, time.November, 26, 12, 0, 0, 0, time.UTC)},
+		{in: "November 22nd, 2024", want: time.Date(2024, time.November, 22, 0, 0, 0, 0, time.UTC)},
+		{in: "the 3rd of March", want: time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "21st Dec", policy: YearPast, want: time.Date(2023, time.December, 21, 0, 0, 0, 0, time.UTC), inferred: true},
+		{in: "13:22:05.000", want: time.Date(0, time.January, 1, 13, 22, 5, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Now: func() time.Time { return ref }, YearPolicy: tt.policy}
+		got, err := p.ParseResult(tt.in)
+		if err != nil {
+			t.Errorf("ParseResult(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want) {
+			t.Errorf("ParseResult(\"%s\") = %v, want %v", tt.in, got.Time, tt.want)
+		}
+		if got.YearInferred != tt.inferred {
+			t.Errorf("ParseResult(\"%s\").YearInferred = %v, want %v", tt.in, got.YearInferred, tt.inferred)
+		}
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	p := Parser{Location: loc}
+
+	got, err := p.Parse("2024-11-14 22:43:57")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, time.November, 14, 22, 43, 57, 0, loc); !got.Equal(want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestParseErr(t *testing.T) {
+	tests := []string{
+		"",
+		"hello",
+		"45620",
+		"2025-13-26",
+		"2023-02-29",
+	}
+
+	for _, in := range tests {
+		got, err := Parse(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("Parse(\"%s\") = %v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
+
+func TestIsDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{in: "2024-11-24", want: true},
+		{in: "2024-11-24T15:04:05Z", want: true},
+		{in: "Nov 24", want: true},
+		{in: "24 November 2024", want: true},
+		{in: "Sun, 06 Nov 1994 08:49:37 GMT", want: true},
+		{in: "2024-W47-7", want: true},
+		{in: "2024-331", want: true},
+		{in: "20241124", want: true},
+		{in: "11/24/2024", want: true},
+		{in: "1.2", want: false},
+		{in: "2024", want: false},
+		{in: "2024-11", want: false},
+		{in: "15:04", want: false},
+		{in: "42", want: false},
+		{in: "hello", want: false},
+		{in: "", want: false},
+	}
+
+	for _, tt := range tests {
+		p := Parser{}
+		if got := p.IsDate(tt.in); got != tt.want {
+			t.Errorf("IsDate(\"%s\") = %t, want %t", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "03/04/2024", want: []string{"01/02/2006", "02/01/2006"}},
+		{in: "13/04/2024", want: []string{"02/01/2006"}},
+		{in: "24/11/05", want: []string{"02/01/06", "06/01/02"}},
+		{in: "03/04/05", want: []string{"01/02/06", "02/01/06", "06/01/02"}},
+		{in: "2024-11-24", want: []string{"2006-01-02"}},
+	}
+
+	for _, tt := range tests {
+		got, err := Candidates(tt.in)
+		if err != nil {
+			t.Errorf("Candidates(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("Candidates(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := Candidates("2024-W47-7"); !errors.Is(err, ErrNoLayout) {
+		t.Errorf("Candidates(\"2024-W47-7\") error = %v, want ErrNoLayout", err)
+	}
+	if _, err := Candidates("hello"); !errors.Is(err, ErrInvalidDateFormat) {
+		t.Errorf("Candidates(\"hello\") error = %v, want ErrInvalidDateFormat", err)
+	}
+}
@@ -0,0 +1,221 @@
+package goanydate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Range is a pair of dates parsed from prose such as "Nov 3 – 7, 2024".
+type Range struct {
+	Start, End time.Time
+	// StartLayout and EndLayout are the layouts of the endpoints once the
+	// parts they share have been filled in, e.g. "Jan 2, 2006".
+	StartLayout, EndLayout string
+}
+
+var rangeWords = []string{"to", "until", "till", "through", "thru", "and"}
+
+// ParseRange parses a date range with the zero Parser.
+func ParseRange(s string) (Range, error) {
+	p := Parser{}
+	return p.ParseRange(s)
+}
+
+// ParseRange parses a date range written in prose:
+//
+//	Nov 3 – 7, 2024
+//	Nov 28 – Dec 2, 2024
+//	from 1 Dec to 5 Dec
+//	between 2024-11-03 and 2024-11-07
+//
+// Endpoints are separated by "to", "until", "through", an en or em dash or a
+// hyphen. A month or year written once is shared by both endpoints; when
+// neither has a year it is inferred with the parser's YearPolicy, and an end
+// that would precede the start moves to the following year.
+func (p *Parser) ParseRange(s string) (Range, error) {
+	d := adDetector{}
+	chunks := d.parse(strings.TrimSpace(Normalize(s).Text))
+	if len(chunks) > 0 && (strings.EqualFold(chunks[0].Value, "from") || strings.EqualFold(chunks[0].Value, "between")) {
+		chunks = chunks[1:]
+	}
+
+	for _, i := range rangeSeparators(chunks) {
+		if r, err := p.rangeFrom(chunks[:i], chunks[i+1:]); err == nil {
+			return r, nil
+		}
+	}
+
+	return Range{}, ErrInvalidDateFormat
+}
+
+// rangeSeparators returns the positions of chunks that may separate the two
+// endpoints. Only the strongest kind present is considered, so the hyphens
+// inside "2024-11-03 - 2024-11-07" are never tried.
+func rangeSeparators(chunks []adChunk) []int {
+	var words, dashes, spaced, bare []int
+	for i, c := range chunks {
+		switch {
+		case c.Type == "letter":
+			for _, w := range rangeWords {
+				if strings.EqualFold(c.Value, w) {
+					words = append(words, i)
+				}
+			}
+		case c.Value == "–" || c.Value == "—":
+			dashes = append(dashes, i)
+		case c.Value == "-":
+			if i > 0 && i+1 < len(chunks) && chunks[i-1].Value == " " && chunks[i+1].Value == " " {
+				spaced = append(spaced, i)
+			} else {
+				bare = append(bare, i)
+			}
+		}
+	}
+
+	for _, seps := range [][]int{words, dashes, spaced} {
+		if len(seps) > 0 {
+			return seps
+		}
+	}
+	return bare
+}
+
+// rangeDate is an endpoint made of a month name, a day and an optional year.
+type rangeDate struct {
+	month, day, year string
+	monthFirst       bool
+}
+
+// parseRangeDate reads an endpoint that may leave out its month or year.
+func parseRangeDate(chunks []adChunk) (rangeDate, bool) {
+	var r rangeDate
+	for _, c := range chunks {
+		switch {
+		case c.Type == "letter" && (isShortMonth(c.Value) || isLongMonth(c.Value)) && r.month == "":
+			r.month = c.Value
+			r.monthFirst = r.day == ""
+		case c.Type == "letter" && (isShortWeekDay(c.Value) || isLongWeekDay(c.Value)):
+		case c.Type == "letter" && (strings.EqualFold(c.Value, "the") || strings.EqualFold(c.Value, "of")):
+		case c.Type == "letter" && isOrdinalSuffix(c.Value) && r.day != "":
+			if n, _ := strconv.Atoi(r.day); !strings.EqualFold(c.Value, ordinalSuffix(n)) {
+				return r, false
+			}
+		case c.Type == "digit" && len(c.Value) <= 2 && r.day == "":
+			r.day = c.Value
+		case c.Type == "digit" && len(c.Value) == 4 && r.year == "":
+			r.year = c.Value
+		case c.Type == "sep" && (c.Value == " " || c.Value == "," || c.Value == "."):
+		default:
+			return r, false
+		}
+	}
+	return r, r.day != ""
+}
+
+func (r rangeDate) String(monthFirst bool) string {
+	s := r.day + " " + r.month
+	if monthFirst {
+		s = r.month + " " + r.day
+	}
+	if r.year != "" {
+		if monthFirst {
+			s += ","
+		}
+		s += " " + r.year
+	}
+	return s
+}
+
+// monthIndex returns the zero-based month of an English month name, or -1.
+func monthIndex(name string) int {
+	for i := range longMonthNames {
+		if strings.EqualFold(name, shortMonthNames[i]) || strings.EqualFold(name, longMonthNames[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func joinChunks(chunks []adChunk) string {
+	var s strings.Builder
+	for _, c := range chunks {
+		s.WriteString(c.Value)
+	}
+	return strings.TrimSpace(s.String())
+}
+
+func (p *Parser) rangeFrom(left, right []adChunk) (Range, error) {
+	l, lok := parseRangeDate(left)
+	r, rok := parseRangeDate(right)
+	if !lok || !rok {
+		return p.rangeOf(joinChunks(left), joinChunks(right), 0)
+	}
+
+	monthFirst := l.monthFirst
+	sameMonth := l.month == "" || r.month == "" || monthIndex(l.month) == monthIndex(r.month)
+	if l.month == "" {
+		l.month, monthFirst = r.month, r.monthFirst
+	}
+	if r.month == "" {
+		r.month = l.month
+	}
+	if l.month == "" {
+		return Range{}, ErrInvalidDateFormat
+	}
+
+	// years borrowed from the other endpoint may need to roll over,
+	// as in "Dec 30 – Jan 2, 2025"
+	shift := 0
+	switch {
+	case l.year == "" && r.year != "":
+		l.year, shift = r.year, -1
+	case r.year == "" && l.year != "":
+		r.year, shift = l.year, 1
+	case l.year == "" && r.year == "":
+		shift = 1
+	}
+	if sameMonth {
+		shift = 0
+	}
+
+	return p.rangeOf(l.String(monthFirst), r.String(monthFirst), shift)
+}
+
+// rangeOf parses both endpoints. When shift is non-zero an end before the
+// start is fixed by moving the start (-1) or the end (1) by a year. When
+// neither endpoint has a year, only the start's is inferred and the end
+// takes the same one.
+func (p *Parser) rangeOf(start, end string, shift int) (Range, error) {
+	s, err := p.ParseResult(start)
+	if err != nil {
+		return Range{}, err
+	}
+	e, err := p.ParseResult(end)
+	if err != nil {
+		return Range{}, err
+	}
+	if s.YearInferred && e.YearInferred {
+		t := e.Time
+		e.Time = time.Date(s.Time.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if e.Time.Month() != t.Month() {
+			// February 29 of a start year that is not leap
+			return Range{}, ErrInvalidDateFormat
+		}
+	}
+	if e.Time.Before(s.Time) {
+		switch shift {
+		case -1:
+			s.Time = s.Time.AddDate(-1, 0, 0)
+		case 1:
+			e.Time = e.Time.AddDate(1, 0, 0)
+		default:
+			return Range{}, ErrInvalidDateFormat
+		}
+	}
+	if e.Time.Before(s.Time) {
+		return Range{}, ErrInvalidDateFormat
+	}
+
+	return Range{Start: s.Time, End: e.Time, StartLayout: s.Layout, EndLayout: e.Layout}, nil
+}
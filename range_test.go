@@ -0,0 +1,100 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	ref := time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		in          string
+		start, end  time.Time
+		startLayout string
+		endLayout   string
+	}{
+		{in: "Nov 3 – 7, 2024", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "Jan 2, 2006", endLayout: "Jan 2, 2006"},
+		{in: "Nov 3-7, 2024", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "Jan 2, 2006", endLayout: "Jan 2, 2006"},
+		{in: "November 28 – December 2, 2024", start: day(2024, 11, 28), end: day(2024, 12, 2), startLayout: "January 02, 2006", endLayout: "January 2, 2006"},
+		{in: "Dec 30 – Jan 2, 2025", start: day(2024, 12, 30), end: day(2025, 1, 2), startLayout: "Jan 02, 2006", endLayout: "Jan 2, 2006"},
+		{in: "3-7 November 2024", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "2 January 2006", endLayout: "2 January 2006"},
+		{in: "from 1 Dec to 5 Dec", start: day(2024, 12, 1), end: day(2024, 12, 5), startLayout: "2 Jan", endLayout: "2 Jan"},
+		{in: "from Dec 28 until Jan 3", start: day(2024, 12, 28), end: day(2025, 1, 3), startLayout: "Jan 02", endLayout: "Jan 2"},
+		{in: "Mon, Nov 4 through Fri, Nov 8 2024", start: day(2024, 11, 4), end: day(2024, 11, 8), startLayout: "Jan 2, 2006", endLayout: "Jan 2, 2006"},
+		{in: "Nov 28, 2024 to Dec 2", start: day(2024, 11, 28), end: day(2024, 12, 2), startLayout: "Jan 02, 2006", endLayout: "Jan 2, 2006"},
+		{in: "from the 1st to the 5th of December", start: day(2024, 12, 1), end: day(2024, 12, 5), startLayout: "2 January", endLayout: "2 January"},
+		{in: "Nov 3rd – 7th, 2024", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "Jan 2, 2006", endLayout: "Jan 2, 2006"},
+		{in: "2024-11-03 - 2024-11-07", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "2006-01-02", endLayout: "2006-01-02"},
+		{in: "between 2024-11-03 and 2024-11-07", start: day(2024, 11, 3), end: day(2024, 11, 7), startLayout: "2006-01-02", endLayout: "2006-01-02"},
+	}
+
+	p := Parser{Now: func() time.Time { return ref }}
+	for _, tt := range tests {
+		got, err := p.ParseRange(tt.in)
+		if err != nil {
+			t.Errorf("ParseRange(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+			t.Errorf("ParseRange(\"%s\") = %v – %v, want %v – %v", tt.in, got.Start, got.End, tt.start, tt.end)
+		}
+		if got.StartLayout != tt.startLayout || got.EndLayout != tt.endLayout {
+			t.Errorf("ParseRange(\"%s\") layouts = %q, %q, want %q, %q", tt.in, got.StartLayout, got.EndLayout, tt.startLayout, tt.endLayout)
+		}
+	}
+}
+
+// With the reference date about six months away, the nearest year of each
+// endpoint on its own can differ.
+func TestParseRangeYearInferred(t *testing.T) {
+	ref := time.Date(2025, time.May, 3, 12, 0, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		in         string
+		policy     YearPolicy
+		start, end time.Time
+	}{
+		{in: "from 1 Nov to 5 Nov", start: day(2025, 11, 1), end: day(2025, 11, 5)},
+		{in: "Nov 1 – 5", start: day(2025, 11, 1), end: day(2025, 11, 5)},
+		{in: "Nov 1 – 5", policy: YearPast, start: day(2024, 11, 1), end: day(2024, 11, 5)},
+		{in: "Nov 1 – 5", policy: YearFuture, start: day(2025, 11, 1), end: day(2025, 11, 5)},
+		{in: "Oct 30 – Nov 5", start: day(2025, 10, 30), end: day(2025, 11, 5)},
+		{in: "from Nov 28 until Jan 3", start: day(2024, 11, 28), end: day(2025, 1, 3)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Now: func() time.Time { return ref }, YearPolicy: tt.policy}
+		got, err := p.ParseRange(tt.in)
+		if err != nil {
+			t.Errorf("ParseRange(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+			t.Errorf("ParseRange(\"%s\") = %v – %v, want %v – %v", tt.in, got.Start, got.End, tt.start, tt.end)
+		}
+	}
+}
+
+func TestParseRangeErr(t *testing.T) {
+	tests := []string{
+		"",
+		"Nov 3",
+		"3 – 7",
+		"Nov 7 – 3, 2024",
+		"2024-11-07 - 2024-11-03",
+		"Nov 3 – Foo 7, 2024",
+		"from 7 Dec to 3 Dec",
+		"Nov 3rd – 7nd, 2024",
+	}
+
+	for _, in := range tests {
+		if got, err := ParseRange(in); err != ErrInvalidDateFormat {
+			t.Errorf("ParseRange(\"%s\") = %+v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
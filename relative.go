@@ -0,0 +1,103 @@
+package goanydate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relativeUnits maps unit words, singular and abbreviated, to one unit of Period.
+var relativeUnits = map[string]Period{
+	"s": {Clock: time.Second}, "sec": {Clock: time.Second}, "second": {Clock: time.Second},
+	"m": {Clock: time.Minute}, "min": {Clock: time.Minute}, "minute": {Clock: time.Minute},
+	"h": {Clock: time.Hour}, "hr": {Clock: time.Hour}, "hour": {Clock: time.Hour},
+	"d": {Days: 1}, "day": {Days: 1},
+	"w": {Weeks: 1}, "wk": {Weeks: 1}, "week": {Weeks: 1},
+	"mo": {Months: 1}, "month": {Months: 1},
+	"y": {Years: 1}, "yr": {Years: 1}, "year": {Years: 1},
+}
+
+// ParseRelative parses a time relative to the present, see Parser.ParseRelative.
+func ParseRelative(s string) (time.Time, error) {
+	p := Parser{}
+	return p.ParseRelative(s)
+}
+
+// ParseRelative parses a time relative to p.Now: "now", "today",
+// "yesterday", "tomorrow", Go durations such as "-1h30m", signed ISO 8601
+// durations such as "-P1D", and phrases such as "3 days ago", "in 2 weeks"
+// or "+1 month". Days start at midnight in the location of p.Now.
+func (p *Parser) ParseRelative(s string) (time.Time, error) {
+	s = strings.ToLower(strings.TrimSpace(Normalize(s).Text))
+	now := p.now()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch s {
+	case "now":
+		return now, nil
+	case "today":
+		return midnight, nil
+	case "yesterday":
+		return midnight.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return midnight.AddDate(0, 0, 1), nil
+	}
+
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		s, sign = s[1:], -1
+	case strings.HasPrefix(s, "in "):
+		s = s[3:]
+	case strings.HasSuffix(s, " ago"):
+		s, sign = s[:len(s)-4], -1
+	}
+	if s == "" {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+
+	d, ok := relativePeriod(s)
+	if !ok {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	if sign < 0 {
+		return d.SubFrom(now), nil
+	}
+	return d.AddTo(now), nil
+}
+
+// relativePeriod parses an unsigned amount of time: "P3D", "1h30m" or "3 days".
+func relativePeriod(s string) (Period, bool) {
+	if strings.HasPrefix(s, "p") {
+		d, err := ParseDuration8601(strings.ToUpper(s))
+		return d, err == nil
+	}
+	if c, err := time.ParseDuration(s); err == nil {
+		return Period{Clock: c}, true
+	}
+
+	num, unit, ok := strings.Cut(s, " ")
+	if !ok {
+		// "3d", "2mo"
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		num, unit = s[:i], s[i:]
+	}
+	n, err := strconv.Atoi(num)
+	if err != nil {
+		return Period{}, false
+	}
+	u, ok := relativeUnits[strings.TrimSpace(unit)]
+	if !ok {
+		u, ok = relativeUnits[strings.TrimSuffix(strings.TrimSpace(unit), "s")]
+	}
+	if !ok {
+		return Period{}, false
+	}
+
+	return Period{Years: u.Years * n, Months: u.Months * n, Weeks: u.Weeks * n, Days: u.Days * n, Clock: u.Clock * time.Duration(n)}, true
+}
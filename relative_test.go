@@ -0,0 +1,62 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelative(t *testing.T) {
+	now := time.Date(2024, time.November, 24, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "now", want: now},
+		{in: "Today", want: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC)},
+		{in: "yesterday", want: time.Date(2024, time.November, 23, 0, 0, 0, 0, time.UTC)},
+		{in: "tomorrow", want: time.Date(2024, time.November, 25, 0, 0, 0, 0, time.UTC)},
+		{in: "-2h", want: now.Add(-2 * time.Hour)},
+		{in: "+1h30m", want: now.Add(90 * time.Minute)},
+		{in: "90s", want: now.Add(90 * time.Second)},
+		{in: "3 days ago", want: time.Date(2024, time.November, 21, 15, 4, 5, 0, time.UTC)},
+		{in: "1 day ago", want: time.Date(2024, time.November, 23, 15, 4, 5, 0, time.UTC)},
+		{in: "in 2 weeks", want: time.Date(2024, time.December, 8, 15, 4, 5, 0, time.UTC)},
+		{in: "+1 month", want: time.Date(2024, time.December, 24, 15, 4, 5, 0, time.UTC)},
+		{in: "2mo ago", want: time.Date(2024, time.September, 24, 15, 4, 5, 0, time.UTC)},
+		{in: "1 year ago", want: time.Date(2023, time.November, 24, 15, 4, 5, 0, time.UTC)},
+		{in: "5 mins ago", want: now.Add(-5 * time.Minute)},
+		{in: "-P1DT2H", want: time.Date(2024, time.November, 23, 13, 4, 5, 0, time.UTC)},
+		{in: "P1W", want: time.Date(2024, time.December, 1, 15, 4, 5, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Now: func() time.Time { return now }}
+		got, err := p.ParseRelative(tt.in)
+		if err != nil {
+			t.Errorf("ParseRelative(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseRelative(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseRelativeErr(t *testing.T) {
+	tests := []string{
+		"",
+		"ago",
+		"in",
+		"3 fortnights ago",
+		"days ago",
+		"2024-11-24",
+		"-",
+		"P",
+	}
+
+	for _, in := range tests {
+		if got, err := ParseRelative(in); err == nil {
+			t.Errorf("ParseRelative(\"%s\") = %v, want error", in, got)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Package server exposes date detection and parsing over HTTP with JSON
+// bodies, for services not written in Go.
+//
+// Every endpoint takes POST requests with a body such as
+//
+//	{"input": "Nov 24, 2024", "order": "dmy", "location": "Europe/Berlin"}
+//
+// or, for a batch, {"inputs": ["Nov 24, 2024", "03/04/2024"]}. /convert also
+// takes the target "layout", RFC 3339 by default. A single input answers
+// with one result, a batch with {"results": [...]} in input order.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+// Options configures a Handler.
+type Options struct {
+	// Parser is the base configuration, which requests can adjust.
+	Parser goanydate.Parser
+	// MaxBodyBytes limits request bodies. Defaults to 1 MiB.
+	MaxBodyBytes int64
+	// MaxBatch limits the inputs of a batch. Defaults to 10000.
+	MaxBatch int
+}
+
+// Request is the body of every endpoint.
+type Request struct {
+	Input    *string  `json:"input,omitempty"`
+	Inputs   []string `json:"inputs,omitempty"`
+	Order    string   `json:"order,omitempty"`
+	Location string   `json:"location,omitempty"`
+	Layout   string   `json:"layout,omitempty"`
+}
+
+// Result is the outcome for one input. Which fields are set depends on the
+// endpoint.
+type Result struct {
+	Input        string   `json:"input"`
+	Layout       string   `json:"layout,omitempty"`
+	Layouts      []string `json:"layouts,omitempty"`
+	Time         string   `json:"time,omitempty"`
+	YearInferred bool     `json:"year_inferred,omitempty"`
+	Output       string   `json:"output,omitempty"`
+	Error        *Error   `json:"error,omitempty"`
+}
+
+// Error is a structured error. Code is one of bad_request, invalid_option,
+// invalid_date and no_layout.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type batchResponse struct {
+	Results []Result `json:"results"`
+}
+
+type errorResponse struct {
+	Error *Error `json:"error"`
+}
+
+// Handler serves the detection endpoints.
+type Handler struct {
+	opts Options
+	mux  *http.ServeMux
+}
+
+// New returns a Handler serving POST /detect, /parse, /candidates and /convert.
+func New(opts Options) *Handler {
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = 1 << 20
+	}
+	if opts.MaxBatch <= 0 {
+		opts.MaxBatch = 10000
+	}
+	h := &Handler{opts: opts, mux: http.NewServeMux()}
+	h.mux.HandleFunc("POST /detect", h.endpoint(detect))
+	h.mux.HandleFunc("POST /parse", h.endpoint(parse))
+	h.mux.HandleFunc("POST /candidates", h.endpoint(candidates))
+	h.mux.HandleFunc("POST /convert", h.endpoint(convert))
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+type operation func(p *goanydate.Parser, req *Request, input string) Result
+
+func (h *Handler) endpoint(op operation) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.opts.MaxBodyBytes))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", err.Error())
+			return
+		}
+		if (req.Input == nil) == (req.Inputs == nil) {
+			writeError(w, http.StatusBadRequest, "bad_request", "exactly one of input and inputs is required")
+			return
+		}
+		if len(req.Inputs) > h.opts.MaxBatch {
+			writeError(w, http.StatusRequestEntityTooLarge, "bad_request", "too many inputs")
+			return
+		}
+
+		p, err := h.parser(&req)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_option", err.Error())
+			return
+		}
+
+		if req.Input != nil {
+			res := op(p, &req, *req.Input)
+			status := http.StatusOK
+			if res.Error != nil {
+				status = http.StatusUnprocessableEntity
+			}
+			writeJSON(w, status, res)
+			return
+		}
+		results := make([]Result, len(req.Inputs))
+		for i, in := range req.Inputs {
+			results[i] = op(p, &req, in)
+		}
+		writeJSON(w, http.StatusOK, batchResponse{Results: results})
+	}
+}
+
+// parser applies the options of req to the base parser.
+func (h *Handler) parser(req *Request) (*goanydate.Parser, error) {
+	p := h.opts.Parser
+	if req.Order != "" {
+		order, ok := goanydate.ParseDateOrder(req.Order)
+		if !ok {
+			return nil, errors.New("unknown order " + req.Order)
+		}
+		p.Order = order
+	}
+	if req.Location != "" {
+		loc, err := time.LoadLocation(req.Location)
+		if err != nil {
+			return nil, err
+		}
+		p.Location = loc
+	}
+	return &p, nil
+}
+
+func detect(p *goanydate.Parser, _ *Request, input string) Result {
+	res := Result{Input: input}
+	r, err := p.ParseResult(input)
+	if err == nil && r.Layout == "" {
+		err = goanydate.ErrNoLayout
+	}
+	if err != nil {
+		res.Error = dateError(err)
+		return res
+	}
+	res.Layout = r.Layout
+	return res
+}
+
+func parse(p *goanydate.Parser, _ *Request, input string) Result {
+	res, _ := parseTime(p, input)
+	return res
+}
+
+func parseTime(p *goanydate.Parser, input string) (Result, time.Time) {
+	res := Result{Input: input}
+	r, err := p.ParseResult(input)
+	if err != nil {
+		res.Error = dateError(err)
+		return res, time.Time{}
+	}
+	res.Layout, res.YearInferred = r.Layout, r.YearInferred
+	res.Time = r.Time.Format(time.RFC3339Nano)
+	return res, r.Time
+}
+
+func candidates(p *goanydate.Parser, _ *Request, input string) Result {
+	res := Result{Input: input}
+	layouts, err := p.Candidates(input)
+	if err != nil {
+		res.Error = dateError(err)
+		return res
+	}
+	res.Layouts = layouts
+	return res
+}
+
+func convert(p *goanydate.Parser, req *Request, input string) Result {
+	res, t := parseTime(p, input)
+	if res.Error != nil {
+		return res
+	}
+	layout := req.Layout
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	res.Output = t.Format(layout)
+	return res
+}
+
+func dateError(err error) *Error {
+	if errors.Is(err, goanydate.ErrNoLayout) {
+		return &Error{Code: "no_layout", Message: err.Error()}
+	}
+	return &Error{Code: "invalid_date", Message: err.Error()}
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorResponse{Error: &Error{Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+func post(t *testing.T, srv *httptest.Server, path, body string) (int, map[string]any) {
+	t.Helper()
+	resp, err := http.Post(srv.URL+path, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST %s failed with %s", path, err)
+	}
+	defer resp.Body.Close()
+
+	var m map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil {
+		t.Fatalf("POST %s: response is not JSON: %s", path, err)
+	}
+	return resp.StatusCode, m
+}
+
+func TestEndpoints(t *testing.T) {
+	srv := httptest.NewServer(New(Options{Parser: goanydate.Parser{
+		Now: func() time.Time { return time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC) },
+	}}))
+	defer srv.Close()
+
+	tests := []struct {
+		path, body string
+		status     int
+		want       map[string]any
+	}{
+		{path: "/detect", body: `{"input": "2024-11-24 15:04:05"}`, status: 200, want: map[string]any{"layout": "2006-01-02 15:04:05"}},
+		{path: "/detect", body: `{"input": "2024-W47-7"}`, status: 422, want: map[string]any{"error": map[string]any{"code": "no_layout", "message": "date format has no Go layout"}}},
+		{path: "/detect", body: `{"input": "hello"}`, status: 422, want: map[string]any{"error": map[string]any{"code": "invalid_date", "message": "invalid date format"}}},
+		{path: "/parse", body: `{"input": "Nov 24"}`, status: 200, want: map[string]any{"time": "2024-11-24T00:00:00Z", "layout": "Jan 02", "year_inferred": true}},
+		{path: "/parse", body: `{"input": "03/04/2024", "order": "dmy", "location": "Europe/Berlin"}`, status: 200, want: map[string]any{"time": "2024-04-03T00:00:00+02:00"}},
+		{path: "/candidates", body: `{"input": "03/04/2024"}`, status: 200, want: map[string]any{"layouts": []any{"01/02/2006", "02/01/2006"}}},
+		{path: "/convert", body: `{"input": "24 Nov 2024 15:04", "layout": "2006-01-02T15:04"}`, status: 200, want: map[string]any{"output": "2024-11-24T15:04"}},
+		{path: "/convert", body: `{"input": "Nov 24, 2024"}`, status: 200, want: map[string]any{"output": "2024-11-24T00:00:00Z"}},
+	}
+
+	for _, tt := range tests {
+		status, got := post(t, srv, tt.path, tt.body)
+		if status != tt.status {
+			t.Errorf("POST %s %s status = %d, want %d", tt.path, tt.body, status, tt.status)
+		}
+		for k, v := range tt.want {
+			gj, _ := json.Marshal(got[k])
+			wj, _ := json.Marshal(v)
+			if string(gj) != string(wj) {
+				t.Errorf("POST %s %s: %s = %s, want %s", tt.path, tt.body, k, gj, wj)
+			}
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	srv := httptest.NewServer(New(Options{}))
+	defer srv.Close()
+
+	status, got := post(t, srv, "/parse", `{"inputs": ["2024-11-24", "hello", "24/11/2024"]}`)
+	if status != 200 {
+		t.Fatalf("status = %d, want 200", status)
+	}
+	results, _ := got["results"].([]any)
+	if len(results) != 3 {
+		t.Fatalf("results = %v, want 3", got["results"])
+	}
+	for i, want := range []string{"2024-11-24T00:00:00Z", "", "2024-11-24T00:00:00Z"} {
+		r := results[i].(map[string]any)
+		if want == "" && r["error"] == nil || want != "" && r["time"] != want {
+			t.Errorf("result %d = %v, want %s", i, r, want)
+		}
+	}
+}
+
+func TestBadRequests(t *testing.T) {
+	srv := httptest.NewServer(New(Options{MaxBatch: 2}))
+	defer srv.Close()
+
+	tests := []struct {
+		body   string
+		status int
+		code   string
+	}{
+		{body: `not json`, status: 400, code: "bad_request"},
+		{body: `{}`, status: 400, code: "bad_request"},
+		{body: `{"input": "2024", "inputs": ["2024"]}`, status: 400, code: "bad_request"},
+		{body: `{"input": "2024", "unknown": 1}`, status: 400, code: "bad_request"},
+		{body: `{"inputs": ["a", "b", "c"]}`, status: 413, code: "bad_request"},
+		{body: `{"input": "2024", "order": "xyz"}`, status: 400, code: "invalid_option"},
+		{body: `{"input": "2024", "location": "Nowhere/Special"}`, status: 400, code: "invalid_option"},
+	}
+
+	for _, tt := range tests {
+		status, got := post(t, srv, "/parse", tt.body)
+		e, _ := got["error"].(map[string]any)
+		if status != tt.status || e["code"] != tt.code {
+			t.Errorf("POST %s = %d %v, want %d %s", tt.body, status, got, tt.status, tt.code)
+		}
+	}
+
+	resp, err := http.Get(srv.URL + "/parse")
+	if err != nil {
+		t.Fatalf("GET failed with %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /parse status = %d, want 405", resp.StatusCode)
+	}
+}
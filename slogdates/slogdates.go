@@ -0,0 +1,130 @@
+// Package slogdates provides a log/slog handler that turns date strings in
+// attributes into time.Time values.
+package slogdates
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	goanydate "github.com/nodivbyzero/go-anydate"
+)
+
+// Options configures a Handler.
+type Options struct {
+	// Keys lists the attribute keys whose string values are parsed as
+	// dates. When empty, every string value that Parser.IsDate accepts is
+	// converted. Keys are matched without their group prefix.
+	Keys []string
+	// Parser parses the dates.
+	Parser goanydate.Parser
+	// TimeKey, when set, names an attribute whose date becomes the record
+	// time. The attribute is then dropped. One added with WithAttrs applies
+	// to every record that does not carry its own.
+	TimeKey string
+}
+
+// Handler converts date strings in attributes to time values and passes the
+// records on to another handler.
+type Handler struct {
+	next slog.Handler
+	opts Options
+	keys map[string]bool
+	// time is the record time taken from a TimeKey attribute in WithAttrs.
+	time time.Time
+}
+
+// NewHandler returns a Handler that passes converted records to next.
+func NewHandler(next slog.Handler, opts Options) *Handler {
+	h := &Handler{next: next, opts: opts}
+	if len(opts.Keys) > 0 {
+		h.keys = map[string]bool{}
+		for _, k := range opts.Keys {
+			h.keys[k] = true
+		}
+	}
+	return h
+}
+
+// Enabled implements slog.Handler.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	if !h.time.IsZero() {
+		out.Time = h.time
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		if h.opts.TimeKey != "" && a.Key == h.opts.TimeKey {
+			if t, ok := h.parse(a.Value.Resolve(), true); ok {
+				out.Time = t
+				return true
+			}
+		}
+		out.AddAttrs(h.convert(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	t := h.time
+	converted := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		if h.opts.TimeKey != "" && a.Key == h.opts.TimeKey {
+			if at, ok := h.parse(a.Value.Resolve(), true); ok {
+				t = at
+				continue
+			}
+		}
+		converted = append(converted, h.convert(a))
+	}
+	return &Handler{next: h.next.WithAttrs(converted), opts: h.opts, keys: h.keys, time: t}
+}
+
+// WithGroup implements slog.Handler.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name), opts: h.opts, keys: h.keys, time: h.time}
+}
+
+func (h *Handler) convert(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	switch a.Value.Kind() {
+	case slog.KindString:
+		if h.keys != nil && !h.keys[a.Key] {
+			return a
+		}
+		if t, ok := h.parse(a.Value, h.keys != nil); ok {
+			return slog.Time(a.Key, t)
+		}
+	case slog.KindGroup:
+		group := a.Value.Group()
+		converted := make([]any, len(group))
+		for i, g := range group {
+			converted[i] = h.convert(g)
+		}
+		return slog.Group(a.Key, converted...)
+	}
+	return a
+}
+
+// parse parses a string value. Values of listed keys only need to parse,
+// others must also look like a full date.
+func (h *Handler) parse(v slog.Value, listed bool) (t time.Time, ok bool) {
+	if v.Kind() != slog.KindString {
+		return t, false
+	}
+	s := v.String()
+	if !listed && !h.opts.Parser.IsDate(s) {
+		return t, false
+	}
+	r, err := h.opts.Parser.ParseResult(s)
+	if err != nil {
+		return t, false
+	}
+	return r.Time, true
+}
@@ -0,0 +1,93 @@
+package slogdates
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func logLine(t *testing.T, opts Options, log func(*slog.Logger)) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	log(slog.New(NewHandler(slog.NewJSONHandler(&buf, nil), opts)))
+
+	var m map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+		t.Fatalf("log output %s is not JSON: %s", buf.String(), err)
+	}
+	return m
+}
+
+func TestHandlerSniff(t *testing.T) {
+	m := logLine(t, Options{}, func(l *slog.Logger) {
+		l.With("deployed", "Nov 24, 2024").Info("hello",
+			"created", "24/11/2024 15:04",
+			"version", "1.2",
+			"count", 3,
+			slog.Group("req", "started", "2024-11-24T15:04:05+09:00", "path", "/"))
+	})
+
+	want := map[string]string{
+		"deployed": "2024-11-24T00:00:00Z",
+		"created":  "2024-11-24T15:04:00Z",
+		"version":  "1.2",
+	}
+	for k, v := range want {
+		if m[k] != v {
+			t.Errorf("%s = %v, want %s", k, m[k], v)
+		}
+	}
+	if req, _ := m["req"].(map[string]any); req["started"] != "2024-11-24T15:04:05+09:00" || req["path"] != "/" {
+		t.Errorf("req = %v, want the started time and path", m["req"])
+	}
+}
+
+func TestHandlerKeys(t *testing.T) {
+	m := logLine(t, Options{Keys: []string{"since"}}, func(l *slog.Logger) {
+		l.Info("hello", "since", "Nov 24", "created", "2024-11-24")
+	})
+
+	if got, _ := m["since"].(string); len(got) < 10 || got[4:10] != "-11-24" {
+		t.Errorf("since = %v, want a time on November 24", m["since"])
+	}
+	if m["created"] != "2024-11-24" {
+		t.Errorf("created = %v, want it unchanged", m["created"])
+	}
+}
+
+func TestHandlerTimeKey(t *testing.T) {
+	m := logLine(t, Options{TimeKey: "ts"}, func(l *slog.Logger) {
+		l.WithGroup("g").Info("hello", "ts", "12/Dec/2024:16:36:17 -0700", "other", "x")
+	})
+
+	want := time.Date(2024, time.December, 12, 16, 36, 17, 0, time.FixedZone("", -7*3600)).Format(time.RFC3339)
+	if m["time"] != want {
+		t.Errorf("time = %v, want %s", m["time"], want)
+	}
+	if g, _ := m["g"].(map[string]any); g["ts"] != nil || g["other"] != "x" {
+		t.Errorf("g = %v, want ts dropped and other kept", m["g"])
+	}
+}
+
+func TestHandlerTimeKeyWithAttrs(t *testing.T) {
+	m := logLine(t, Options{TimeKey: "ts"}, func(l *slog.Logger) {
+		l.With("ts", "2024-11-24T15:04:05Z", "other", "x").Info("hello")
+	})
+
+	if m["time"] != "2024-11-24T15:04:05Z" {
+		t.Errorf("time = %v, want 2024-11-24T15:04:05Z", m["time"])
+	}
+	if m["ts"] != nil || m["other"] != "x" {
+		t.Errorf("attrs = %v, want ts dropped and other kept", m)
+	}
+
+	// a record's own TimeKey attribute wins
+	m = logLine(t, Options{TimeKey: "ts"}, func(l *slog.Logger) {
+		l.With("ts", "2024-11-24T15:04:05Z").Info("hello", "ts", "2024-11-25T10:00:00Z")
+	})
+	if m["time"] != "2024-11-25T10:00:00Z" {
+		t.Errorf("time = %v, want 2024-11-25T10:00:00Z", m["time"])
+	}
+}
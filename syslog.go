@@ -0,0 +1,184 @@
+package goanydate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyslogMessage describes the header of a BSD (RFC 3164) or RFC 5424 syslog line.
+type SyslogMessage struct {
+	// Priority is the PRI value, or -1 when the line has none.
+	Priority int
+	// Version is the RFC 5424 protocol version, 0 for RFC 3164 lines.
+	Version int
+	Time    time.Time
+	// Layout is the Go layout of the timestamp alone.
+	Layout string
+	// YearInferred is set for RFC 3164 timestamps, which carry no year.
+	YearInferred bool
+	Hostname     string
+	// BodyOffset is the byte offset in the line where the message body begins.
+	BodyOffset int
+}
+
+// ParseSyslog parses the header of a syslog line with the zero Parser.
+func ParseSyslog(line string) (SyslogMessage, error) {
+	p := Parser{}
+	return p.ParseSyslog(line)
+}
+
+// ParseSyslog parses the header of a syslog line, skipping the PRI and version
+// prefix. The year of RFC 3164 timestamps is inferred from the reference time
+// using the parser's YearPolicy, so "Dec 31" logged just before a January
+// reference time lands in the previous year.
+func (p *Parser) ParseSyslog(line string) (SyslogMessage, error) {
+	m := SyslogMessage{Priority: -1}
+	pos := 0
+
+	if strings.HasPrefix(line, "<") {
+		end := strings.IndexByte(line, '>')
+		if end < 2 || end > 4 || !isNumber(line[1:end]) {
+			return m, ErrInvalidDateFormat
+		}
+		v, err := strconv.Atoi(line[1:end])
+		if err != nil || v > 191 {
+			return m, ErrInvalidDateFormat
+		}
+		m.Priority = v
+		pos = end + 1
+	}
+
+	var err error
+	if pos < len(line) && line[pos] >= '0' && line[pos] <= '9' {
+		err = p.parseSyslog5424(line, pos, &m)
+	} else {
+		err = p.parseSyslog3164(line, pos, &m)
+	}
+	if err != nil {
+		return SyslogMessage{Priority: -1}, err
+	}
+
+	return m, nil
+}
+
+// parseSyslog3164 parses "Mmm dd hh:mm:ss HOSTNAME MSG".
+func (p *Parser) parseSyslog3164(line string, pos int, m *SyslogMessage) error {
+	if len(line)-pos < len(time.Stamp) {
+		return ErrInvalidDateFormat
+	}
+	ts := line[pos : pos+len(time.Stamp)]
+	pos += len(time.Stamp)
+	if pos < len(line) && line[pos] != ' ' {
+		return ErrInvalidDateFormat
+	}
+	if _, err := time.Parse(time.Stamp, ts); err != nil {
+		return ErrInvalidDateFormat
+	}
+
+	layout, err := DetectFormat(ts)
+	if err != nil {
+		return err
+	}
+	t, err := time.ParseInLocation(layout, ts, p.location())
+	if err != nil {
+		return ErrInvalidDateFormat
+	}
+	m.Time = inferYear(t, p.now(), p.YearPolicy)
+	m.Layout = layout
+	m.YearInferred = true
+
+	pos = skipSpace(line, pos)
+	// lines written to a local socket often omit the hostname
+	host, next := syslogField(line, pos)
+	if host != "" && !strings.HasSuffix(host, ":") && !strings.Contains(host, "[") {
+		m.Hostname = host
+		pos = next
+	}
+	m.BodyOffset = pos
+
+	return nil
+}
+
+// parseSyslog5424 parses "VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID SD MSG".
+func (p *Parser) parseSyslog5424(line string, pos int, m *SyslogMessage) error {
+	version, pos := syslogField(line, pos)
+	if len(version) > 2 || !isNumber(version) {
+		return ErrInvalidDateFormat
+	}
+	m.Version, _ = strconv.Atoi(version)
+
+	ts, pos := syslogField(line, pos)
+	if ts == "" || ts == "-" {
+		return ErrInvalidDateFormat
+	}
+	layout, err := DetectFormat(ts)
+	if err != nil {
+		return err
+	}
+	t, err := time.ParseInLocation(layout, ts, p.location())
+	if err != nil {
+		return ErrInvalidDateFormat
+	}
+	m.Time = t
+	m.Layout = layout
+
+	host, pos := syslogField(line, pos)
+	if host != "-" {
+		m.Hostname = host
+	}
+	// APP-NAME, PROCID and MSGID
+	for i := 0; i < 3; i++ {
+		_, pos = syslogField(line, pos)
+	}
+
+	// STRUCTURED-DATA is either "-" or a sequence of [...] elements
+	if pos < len(line) && line[pos] == '-' {
+		pos++
+	}
+	for pos < len(line) && line[pos] == '[' {
+		pos = skipSDElement(line, pos)
+	}
+	m.BodyOffset = skipSpace(line, pos)
+
+	return nil
+}
+
+// syslogField returns the space delimited field starting at pos and the
+// position following the delimiter.
+func syslogField(line string, pos int) (string, int) {
+	if pos >= len(line) {
+		return "", len(line)
+	}
+	end := strings.IndexByte(line[pos:], ' ')
+	if end < 0 {
+		return line[pos:], len(line)
+	}
+	return line[pos : pos+end], pos + end + 1
+}
+
+// skipSDElement returns the position following the structured data element
+// starting at pos, honouring escapes inside quoted parameter values.
+func skipSDElement(line string, pos int) int {
+	quoted := false
+	for i := pos + 1; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ']':
+			if !quoted {
+				return i + 1
+			}
+		}
+	}
+	return len(line)
+}
+
+func skipSpace(line string, pos int) int {
+	if pos < len(line) && line[pos] == ' ' {
+		pos++
+	}
+	return pos
+}
@@ -0,0 +1,112 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSyslog(t *testing.T) {
+	ref := time.Date(2024, time.November, 24, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in       string
+		want     SyslogMessage
+		wantBody string
+	}{
+		{
+			in: "<34>Oct 11 22:14:15 mymachine su: 'su root' failed for lonvick on /dev/pts/8",
+			want: SyslogMessage{Priority: 34, Time: time.Date(2024, time.October, 11, 22, 14, 15, 0, time.UTC),
+				Layout: "Jan 02 15:04:05", YearInferred: true, Hostname: "mymachine"},
+			wantBody: "su: 'su root' failed for lonvick on /dev/pts/8",
+		},
+		{
+			in: "<13>Feb  5 17:32:18 10.0.0.99 Use the BFG!",
+			want: SyslogMessage{Priority: 13, Time: time.Date(2025, time.February, 5, 17, 32, 18, 0, time.UTC),
+				Layout: "Jan _2 15:04:05", YearInferred: true, Hostname: "10.0.0.99"},
+			wantBody: "Use the BFG!",
+		},
+		{
+			in: "Nov 24 09:00:01 sshd[42]: Accepted publickey",
+			want: SyslogMessage{Priority: -1, Time: time.Date(2024, time.November, 24, 9, 0, 1, 0, time.UTC),
+				Layout: "Jan 02 15:04:05", YearInferred: true},
+			wantBody: "sshd[42]: Accepted publickey",
+		},
+		{
+			in: `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="App]lication"] An application event`,
+			want: SyslogMessage{Priority: 165, Version: 1, Time: time.Date(2003, time.October, 11, 22, 14, 15, 3000000, time.UTC),
+				Layout: "2006-01-02T15:04:05.999Z", Hostname: "mymachine.example.com"},
+			wantBody: "An application event",
+		},
+		{
+			in: "<34>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - %% It's time to make the do-nuts.",
+			want: SyslogMessage{Priority: 34, Version: 1, Time: time.Date(2003, time.August, 24, 12, 14, 15, 3000, time.UTC),
+				Layout: "2006-01-02T15:04:05.999999-07:00", Hostname: "192.0.2.1"},
+			wantBody: "%% It's time to make the do-nuts.",
+		},
+	}
+
+	p := Parser{Now: func() time.Time { return ref }}
+	for _, tt := range tests {
+		got, err := p.ParseSyslog(tt.in)
+		if err != nil {
+			t.Errorf("ParseSyslog(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want.Time) {
+			t.Errorf("ParseSyslog(\"%s\").Time = %v, want %v", tt.in, got.Time, tt.want.Time)
+		}
+		got.Time = tt.want.Time
+		if got.BodyOffset > len(tt.in) || tt.in[got.BodyOffset:] != tt.wantBody {
+			t.Errorf("ParseSyslog(\"%s\") body = %q, want %q", tt.in, tt.in[got.BodyOffset:], tt.wantBody)
+		}
+		got.BodyOffset = 0
+		if got != tt.want {
+			t.Errorf("ParseSyslog(\"%s\") = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseSyslogRollover(t *testing.T) {
+	tests := []struct {
+		in     string
+		ref    time.Time
+		policy YearPolicy
+		want   int
+	}{
+		{in: "Dec 31 23:59:50 host app: x", ref: time.Date(2025, time.January, 1, 0, 0, 30, 0, time.UTC), want: 2024},
+		{in: "Jan  1 00:00:01 host app: x", ref: time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC), want: 2025},
+		{in: "Jan  1 00:00:01 host app: x", ref: time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC), policy: YearPast, want: 2024},
+		{in: "Nov 23 00:00:01 host app: x", ref: time.Date(2024, time.November, 24, 0, 0, 0, 0, time.UTC), policy: YearFuture, want: 2025},
+		{in: "Feb 29 00:00:01 host app: x", ref: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), policy: YearPast, want: 2024},
+	}
+
+	for _, tt := range tests {
+		p := Parser{Now: func() time.Time { return tt.ref }, YearPolicy: tt.policy}
+		got, err := p.ParseSyslog(tt.in)
+		if err != nil {
+			t.Errorf("ParseSyslog(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if got.Time.Year() != tt.want {
+			t.Errorf("ParseSyslog(\"%s\") year = %d, want %d", tt.in, got.Time.Year(), tt.want)
+		}
+	}
+}
+
+func TestParseSyslogErr(t *testing.T) {
+	tests := []string{
+		"",
+		"<34>",
+		"<999>Oct 11 22:14:15 host app: x",
+		"<3a>Oct 11 22:14:15 host app: x",
+		"<34>Foo 11 22:14:15 host app: x",
+		"<34>1 - host app - - - msg",
+		"<34>123 2003-10-11T22:14:15Z host app - - - msg",
+	}
+
+	for _, in := range tests {
+		got, err := ParseSyslog(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("ParseSyslog(\"%s\") = %+v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package goanydate
+
+import (
+	"strconv"
+	"time"
+)
+
+// weekDate is an ISO 8601 week date such as "2024-W47-3" or "2024W473".
+type weekDate struct {
+	year, week, weekday int
+	extended            bool
+	// rest is whatever follows the date, e.g. "T12:00:00Z".
+	rest string
+}
+
+// parseWeekDate splits input into an ISO 8601 week date and the remainder.
+func parseWeekDate(input string) (weekDate, bool) {
+	w := weekDate{weekday: 1}
+	if len(input) < 7 || !isNumber(input[:4]) {
+		return w, false
+	}
+	w.year, _ = strconv.Atoi(input[:4])
+	s := input[4:]
+	if s[0] == '-' {
+		w.extended = true
+		s = s[1:]
+	}
+	if len(s) < 3 || s[0] != 'W' || !isASCIIDigits(s[1:3]) {
+		return w, false
+	}
+	w.week, _ = strconv.Atoi(s[1:3])
+	s = s[3:]
+
+	day := s
+	if w.extended && len(s) > 0 && s[0] == '-' {
+		day = s[1:]
+	} else if w.extended {
+		day = ""
+	}
+	if len(day) > 0 && isASCIIDigits(day[:1]) {
+		w.weekday = int(day[0] - '0')
+		s = day[1:]
+	}
+	if len(s) > 0 && s[0] != 'T' && s[0] != ' ' {
+		return w, false
+	}
+	w.rest = s
+
+	if w.week < 1 || w.week > isoWeeksInYear(w.year) || w.weekday < 1 || w.weekday > 7 {
+		return w, false
+	}
+	return w, true
+}
+
+// date returns the calendar date of the week date.
+func (w weekDate) date() time.Time {
+	// week 1 is the week holding January 4th
+	jan4 := time.Date(w.year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, (w.week-1)*7+w.weekday-1)
+}
+
+// isoWeeksInYear returns 52 or 53, the number of ISO weeks in year.
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+func isASCIIDigits(v string) bool {
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			return false
+		}
+	}
+	return len(v) > 0
+}
+
+// parseWeekDate parses an ISO 8601 week date by rewriting it as the calendar
+// date it stands for and parsing that, so any time suffix the detector
+// understands is accepted. Week dates have no Go layout.
+func (p *Parser) parseWeekDate(input string) (Result, bool, error) {
+	w, ok := parseWeekDate(input)
+	if !ok {
+		return Result{}, false, nil
+	}
+
+	layout := "20060102"
+	if w.extended {
+		layout = "2006-01-02"
+	}
+	r, err := p.ParseResult(w.date().Format(layout) + w.rest)
+	if err != nil {
+		return Result{}, true, err
+	}
+	r.Layout = ""
+
+	return r, true, nil
+}
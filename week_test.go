@@ -0,0 +1,60 @@
+package goanydate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeekDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "2024-W47-3", want: time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC)},
+		{in: "2024W473", want: time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC)},
+		{in: "2024-W47", want: time.Date(2024, time.November, 18, 0, 0, 0, 0, time.UTC)},
+		{in: "2024W47", want: time.Date(2024, time.November, 18, 0, 0, 0, 0, time.UTC)},
+		{in: "2009-W01-1", want: time.Date(2008, time.December, 29, 0, 0, 0, 0, time.UTC)},
+		{in: "2009-W53-7", want: time.Date(2010, time.January, 3, 0, 0, 0, 0, time.UTC)},
+		{in: "2020-W53-5", want: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "2024-W01-1", want: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "2024-W47-3T12:30:00Z", want: time.Date(2024, time.November, 20, 12, 30, 0, 0, time.UTC)},
+		{in: "2024-W47-3T12:30:00+09:00", want: time.Date(2024, time.November, 20, 3, 30, 0, 0, time.UTC)},
+		{in: "2024-W47-3 12:30", want: time.Date(2024, time.November, 20, 12, 30, 0, 0, time.UTC)},
+		{in: "2024W473T123000Z", want: time.Date(2024, time.November, 20, 12, 30, 0, 0, time.UTC)},
+		{in: "2024W473T12:30", want: time.Date(2024, time.November, 20, 12, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(\"%s\") failed with %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Parse(\"%s\") = %v, want %v", tt.in, got, tt.want)
+		}
+
+		if _, err := DetectFormat(tt.in); err != ErrNoLayout {
+			t.Errorf("DetectFormat(\"%s\") error = %v, want ErrNoLayout", tt.in, err)
+		}
+	}
+}
+
+func TestParseWeekDateErr(t *testing.T) {
+	tests := []string{
+		"2024-W00-1",
+		"2024-W53-1",
+		"2024-W47-8",
+		"2024-W47-0",
+		"2024-W47x",
+		"2024-W47-3T25:00",
+	}
+
+	for _, in := range tests {
+		got, err := Parse(in)
+		if err != ErrInvalidDateFormat {
+			t.Errorf("Parse(\"%s\") = %v, want ErrInvalidDateFormat", in, got)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package goanydate
+
+import (
+	"time"
+)
+
+// YearPolicy selects the year given to dates written without one.
+type YearPolicy uint8
+
+const (
+	// YearNearest picks the year that puts the date closest to the reference time.
+	YearNearest YearPolicy = iota
+	// YearPast picks the latest year that puts the date at or before the reference time.
+	YearPast
+	// YearFuture picks the earliest year that puts the date at or after the reference time.
+	YearFuture
+)
+
+// inferYear moves t, parsed without a year, into the year chosen by policy
+// relative to ref. February 29 only lands on leap years.
+func inferYear(t time.Time, ref time.Time, policy YearPolicy) time.Time {
+	var best time.Time
+	found := false
+	for y := ref.Year() - 8; y <= ref.Year()+8; y++ {
+		c := time.Date(y, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if c.Month() != t.Month() {
+			continue
+		}
+
+		switch policy {
+		case YearPast:
+			if c.After(ref) {
+				continue
+			}
+		case YearFuture:
+			if c.Before(ref) {
+				continue
+			}
+			if !found {
+				best, found = c, true
+			}
+			continue
+		default:
+			if found && absDuration(c.Sub(ref)) >= absDuration(best.Sub(ref)) {
+				continue
+			}
+		}
+		best, found = c, true
+	}
+
+	return best
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}